@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gorilla/websocket"
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/hub"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// ww-replay reruns a recorded match, either headlessly to inspect how it ended or
+// streamed to spectator clients as GameState messages at the recorded speed
+func main() {
+	file := flag.String("file", "", "replay file to play")
+	mapPath := flag.String("map", "", "map file to use instead of the recorded map")
+	addr := flag.String("addr", "", "stream the replay to clients connecting to ws://<addr>/replay instead of running it headlessly")
+	logLevel := flag.String("log-level", "info", "log level")
+	flag.Parse()
+
+	if err := logger.SetLevelFromString(*logLevel); err != nil {
+		logger.Warn("Invalid log level '%s', using default 'info'", *logLevel)
+	}
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	replay, err := hub.LoadReplayFromFile(*file)
+	if err != nil {
+		logger.Fatal("Unable to load replay: %v", err)
+	}
+	if *mapPath != "" {
+		replay.Header.MapPath = *mapPath
+		replay.Header.MapData = nil
+	}
+	logger.Info("[Replay] Room %s, mode %s, seed %d, %d events over %d ticks",
+		replay.Header.RoomId, replay.Header.GameMode, replay.Header.Seed, len(replay.Events), replay.LastTick())
+
+	if *addr == "" {
+		runHeadless(replay)
+		return
+	}
+
+	http.HandleFunc("/replay", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logger.Error("[Replay] Failed to upgrade connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		streamReplay(replay, conn)
+	})
+	logger.Info("[Replay] Streaming at ws://%s/replay", *addr)
+	if err := http.ListenAndServe(*addr, nil); err != nil {
+		logger.Fatal("Replay server stopped: %v", err)
+	}
+}
+
+// runHeadless re-simulates the whole match as fast as possible and logs how it ended
+func runHeadless(replay *hub.Replay) {
+	started := time.Now()
+	var final *multiplayerv1.GameState
+	err := replay.Run(func(state *multiplayerv1.GameState) bool {
+		final = state
+		return true
+	})
+	if err != nil {
+		logger.Fatal("Replay failed: %v", err)
+	}
+	if final == nil {
+		logger.Info("[Replay] Nothing was simulated")
+		return
+	}
+
+	logger.Info("[Replay] Simulated %d ticks in %v", final.Tick, time.Since(started))
+	match := final.GetMatch()
+	logger.Info("[Replay] Round %d ended in phase %v", match.GetRound(), match.GetPhase())
+	for _, score := range match.GetScores() {
+		logger.Info("[Replay] %s (%s) scored %d with %d freezes and %d eliminations",
+			score.GetName(), score.GetPlayerId().GetValue(), score.GetScore(), score.GetFreezes(), score.GetEliminations())
+	}
+	for _, player := range final.Players {
+		logger.Info("[Replay] %s at (%.1f, %.1f) with %d HP, eliminated=%t",
+			player.GetPlayerId().GetValue(), player.GetPosition().GetX(), player.GetPosition().GetY(),
+			player.GetHealth(), player.GetIsEliminated())
+	}
+}
+
+// streamReplay re-simulates the match for one client, sending every step as a full
+// GameState at the speed it was recorded
+func streamReplay(replay *hub.Replay, conn *websocket.Conn) {
+	ticker := time.NewTicker(replay.TickRate())
+	defer ticker.Stop()
+
+	err := replay.Run(func(state *multiplayerv1.GameState) bool {
+		<-ticker.C
+		wire, err := proto.Marshal(&multiplayerv1.GameMessage{
+			Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE,
+			Payload: &multiplayerv1.GameMessage_GameState{
+				GameState: state,
+			},
+		})
+		if err != nil {
+			logger.Error("[Replay] Failed to marshal game state: %v", err)
+			return false
+		}
+		return conn.WriteMessage(websocket.BinaryMessage, wire) == nil
+	})
+	if err != nil {
+		logger.Error("[Replay] Stream failed: %v", err)
+	}
+}
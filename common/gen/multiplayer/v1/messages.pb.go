@@ -25,31 +25,46 @@ const (
 type GameMessageType int32
 
 const (
-	GameMessageType_GAME_MESSAGE_TYPE_UNSPECIFIED  GameMessageType = 0
-	GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE GameMessageType = 1
-	GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT GameMessageType = 2
-	GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE   GameMessageType = 3
-	GameMessageType_GAME_MESSAGE_TYPE_ANNOUNCEMENT GameMessageType = 4
-	GameMessageType_GAME_MESSAGE_TYPE_LOBBY_STATE  GameMessageType = 5
+	GameMessageType_GAME_MESSAGE_TYPE_UNSPECIFIED   GameMessageType = 0
+	GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE  GameMessageType = 1
+	GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT  GameMessageType = 2
+	GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE    GameMessageType = 3
+	GameMessageType_GAME_MESSAGE_TYPE_ANNOUNCEMENT  GameMessageType = 4
+	GameMessageType_GAME_MESSAGE_TYPE_LOBBY_STATE   GameMessageType = 5
+	GameMessageType_GAME_MESSAGE_TYPE_MATCH_RESULTS GameMessageType = 6
+	GameMessageType_GAME_MESSAGE_TYPE_SNAPSHOT_ACK  GameMessageType = 7
+	GameMessageType_GAME_MESSAGE_TYPE_MINIMAP_STATE GameMessageType = 8
+	GameMessageType_GAME_MESSAGE_TYPE_TIME_SYNC     GameMessageType = 9
+	GameMessageType_GAME_MESSAGE_TYPE_SPECTATE      GameMessageType = 10 // Spectator choosing whose view to follow
 )
 
 // Enum value maps for GameMessageType.
 var (
 	GameMessageType_name = map[int32]string{
-		0: "GAME_MESSAGE_TYPE_UNSPECIFIED",
-		1: "GAME_MESSAGE_TYPE_CHAT_MESSAGE",
-		2: "GAME_MESSAGE_TYPE_PLAYER_EVENT",
-		3: "GAME_MESSAGE_TYPE_GAME_STATE",
-		4: "GAME_MESSAGE_TYPE_ANNOUNCEMENT",
-		5: "GAME_MESSAGE_TYPE_LOBBY_STATE",
+		0:  "GAME_MESSAGE_TYPE_UNSPECIFIED",
+		1:  "GAME_MESSAGE_TYPE_CHAT_MESSAGE",
+		2:  "GAME_MESSAGE_TYPE_PLAYER_EVENT",
+		3:  "GAME_MESSAGE_TYPE_GAME_STATE",
+		4:  "GAME_MESSAGE_TYPE_ANNOUNCEMENT",
+		5:  "GAME_MESSAGE_TYPE_LOBBY_STATE",
+		6:  "GAME_MESSAGE_TYPE_MATCH_RESULTS",
+		7:  "GAME_MESSAGE_TYPE_SNAPSHOT_ACK",
+		8:  "GAME_MESSAGE_TYPE_MINIMAP_STATE",
+		9:  "GAME_MESSAGE_TYPE_TIME_SYNC",
+		10: "GAME_MESSAGE_TYPE_SPECTATE",
 	}
 	GameMessageType_value = map[string]int32{
-		"GAME_MESSAGE_TYPE_UNSPECIFIED":  0,
-		"GAME_MESSAGE_TYPE_CHAT_MESSAGE": 1,
-		"GAME_MESSAGE_TYPE_PLAYER_EVENT": 2,
-		"GAME_MESSAGE_TYPE_GAME_STATE":   3,
-		"GAME_MESSAGE_TYPE_ANNOUNCEMENT": 4,
-		"GAME_MESSAGE_TYPE_LOBBY_STATE":  5,
+		"GAME_MESSAGE_TYPE_UNSPECIFIED":   0,
+		"GAME_MESSAGE_TYPE_CHAT_MESSAGE":  1,
+		"GAME_MESSAGE_TYPE_PLAYER_EVENT":  2,
+		"GAME_MESSAGE_TYPE_GAME_STATE":    3,
+		"GAME_MESSAGE_TYPE_ANNOUNCEMENT":  4,
+		"GAME_MESSAGE_TYPE_LOBBY_STATE":   5,
+		"GAME_MESSAGE_TYPE_MATCH_RESULTS": 6,
+		"GAME_MESSAGE_TYPE_SNAPSHOT_ACK":  7,
+		"GAME_MESSAGE_TYPE_MINIMAP_STATE": 8,
+		"GAME_MESSAGE_TYPE_TIME_SYNC":     9,
+		"GAME_MESSAGE_TYPE_SPECTATE":      10,
 	}
 )
 
@@ -80,6 +95,128 @@ func (GameMessageType) EnumDescriptor() ([]byte, []int) {
 	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{0}
 }
 
+type StatusEffectType int32
+
+const (
+	StatusEffectType_STATUS_EFFECT_TYPE_UNSPECIFIED      StatusEffectType = 0
+	StatusEffectType_STATUS_EFFECT_TYPE_FROZEN           StatusEffectType = 1
+	StatusEffectType_STATUS_EFFECT_TYPE_FREEZE_IMMUNITY  StatusEffectType = 2 // Granted when a freeze ends
+	StatusEffectType_STATUS_EFFECT_TYPE_SPEED_BOOST      StatusEffectType = 3 // Granted when a freeze ends
+	StatusEffectType_STATUS_EFFECT_TYPE_SPAWN_PROTECTION StatusEffectType = 4 // Cannot be damaged or frozen after spawning
+	StatusEffectType_STATUS_EFFECT_TYPE_SHIELD           StatusEffectType = 5 // Bought with aloe, blocks damage and freezes
+	StatusEffectType_STATUS_EFFECT_TYPE_INVISIBLE        StatusEffectType = 6 // Hidden from other players' snapshots and the minimap
+	StatusEffectType_STATUS_EFFECT_TYPE_CARRYING_FLAG    StatusEffectType = 7 // Holding the enemy flag in capture the flag, slows movement
+	StatusEffectType_STATUS_EFFECT_TYPE_OUTSIDE_ZONE     StatusEffectType = 8 // Outside the battle royale safe zone, each stack slows further
+)
+
+// Enum value maps for StatusEffectType.
+var (
+	StatusEffectType_name = map[int32]string{
+		0: "STATUS_EFFECT_TYPE_UNSPECIFIED",
+		1: "STATUS_EFFECT_TYPE_FROZEN",
+		2: "STATUS_EFFECT_TYPE_FREEZE_IMMUNITY",
+		3: "STATUS_EFFECT_TYPE_SPEED_BOOST",
+		4: "STATUS_EFFECT_TYPE_SPAWN_PROTECTION",
+		5: "STATUS_EFFECT_TYPE_SHIELD",
+		6: "STATUS_EFFECT_TYPE_INVISIBLE",
+		7: "STATUS_EFFECT_TYPE_CARRYING_FLAG",
+		8: "STATUS_EFFECT_TYPE_OUTSIDE_ZONE",
+	}
+	StatusEffectType_value = map[string]int32{
+		"STATUS_EFFECT_TYPE_UNSPECIFIED":      0,
+		"STATUS_EFFECT_TYPE_FROZEN":           1,
+		"STATUS_EFFECT_TYPE_FREEZE_IMMUNITY":  2,
+		"STATUS_EFFECT_TYPE_SPEED_BOOST":      3,
+		"STATUS_EFFECT_TYPE_SPAWN_PROTECTION": 4,
+		"STATUS_EFFECT_TYPE_SHIELD":           5,
+		"STATUS_EFFECT_TYPE_INVISIBLE":        6,
+		"STATUS_EFFECT_TYPE_CARRYING_FLAG":    7,
+		"STATUS_EFFECT_TYPE_OUTSIDE_ZONE":     8,
+	}
+)
+
+func (x StatusEffectType) Enum() *StatusEffectType {
+	p := new(StatusEffectType)
+	*p = x
+	return p
+}
+
+func (x StatusEffectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEffectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (StatusEffectType) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[1]
+}
+
+func (x StatusEffectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEffectType.Descriptor instead.
+func (StatusEffectType) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+type WorldEventKind int32
+
+const (
+	WorldEventKind_WORLD_EVENT_KIND_UNSPECIFIED   WorldEventKind = 0
+	WorldEventKind_WORLD_EVENT_KIND_QUICKSAND     WorldEventKind = 1 // Patches of tiles slow movement
+	WorldEventKind_WORLD_EVENT_KIND_SANDSTORM     WorldEventKind = 2 // Everyone's view radius shrinks
+	WorldEventKind_WORLD_EVENT_KIND_METEOR_SHOWER WorldEventKind = 3 // Meteors land after a warning and leave freeze zones
+	WorldEventKind_WORLD_EVENT_KIND_ALOE_BLOOM    WorldEventKind = 4 // A cluster of aloe grows in one spot
+)
+
+// Enum value maps for WorldEventKind.
+var (
+	WorldEventKind_name = map[int32]string{
+		0: "WORLD_EVENT_KIND_UNSPECIFIED",
+		1: "WORLD_EVENT_KIND_QUICKSAND",
+		2: "WORLD_EVENT_KIND_SANDSTORM",
+		3: "WORLD_EVENT_KIND_METEOR_SHOWER",
+		4: "WORLD_EVENT_KIND_ALOE_BLOOM",
+	}
+	WorldEventKind_value = map[string]int32{
+		"WORLD_EVENT_KIND_UNSPECIFIED":   0,
+		"WORLD_EVENT_KIND_QUICKSAND":     1,
+		"WORLD_EVENT_KIND_SANDSTORM":     2,
+		"WORLD_EVENT_KIND_METEOR_SHOWER": 3,
+		"WORLD_EVENT_KIND_ALOE_BLOOM":    4,
+	}
+)
+
+func (x WorldEventKind) Enum() *WorldEventKind {
+	p := new(WorldEventKind)
+	*p = x
+	return p
+}
+
+func (x WorldEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorldEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (WorldEventKind) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[2]
+}
+
+func (x WorldEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorldEventKind.Descriptor instead.
+func (WorldEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
 // Types of projectiles
 type ProjectileType int32
 
@@ -114,11 +251,11 @@ func (x ProjectileType) String() string {
 }
 
 func (ProjectileType) Descriptor() protoreflect.EnumDescriptor {
-	return file_multiplayer_v1_messages_proto_enumTypes[1].Descriptor()
+	return file_multiplayer_v1_messages_proto_enumTypes[3].Descriptor()
 }
 
 func (ProjectileType) Type() protoreflect.EnumType {
-	return &file_multiplayer_v1_messages_proto_enumTypes[1]
+	return &file_multiplayer_v1_messages_proto_enumTypes[3]
 }
 
 func (x ProjectileType) Number() protoreflect.EnumNumber {
@@ -127,14 +264,18 @@ func (x ProjectileType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProjectileType.Descriptor instead.
 func (ProjectileType) EnumDescriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{1}
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{3}
 }
 
 type ItemType int32
 
 const (
-	ItemType_ITEM_TYPE_UNSPECIFIED ItemType = 0
-	ItemType_ITEM_TYPE_ALOE        ItemType = 1
+	ItemType_ITEM_TYPE_UNSPECIFIED   ItemType = 0
+	ItemType_ITEM_TYPE_ALOE          ItemType = 1
+	ItemType_ITEM_TYPE_SHIELD        ItemType = 2 // Grants a shield status effect
+	ItemType_ITEM_TYPE_POTION_REFILL ItemType = 3 // Resets every ability cooldown
+	ItemType_ITEM_TYPE_SPEED_SCROLL  ItemType = 4 // Grants a speed boost
+	ItemType_ITEM_TYPE_INVISIBILITY  ItemType = 5 // Grants invisibility
 )
 
 // Enum value maps for ItemType.
@@ -142,10 +283,18 @@ var (
 	ItemType_name = map[int32]string{
 		0: "ITEM_TYPE_UNSPECIFIED",
 		1: "ITEM_TYPE_ALOE",
+		2: "ITEM_TYPE_SHIELD",
+		3: "ITEM_TYPE_POTION_REFILL",
+		4: "ITEM_TYPE_SPEED_SCROLL",
+		5: "ITEM_TYPE_INVISIBILITY",
 	}
 	ItemType_value = map[string]int32{
-		"ITEM_TYPE_UNSPECIFIED": 0,
-		"ITEM_TYPE_ALOE":        1,
+		"ITEM_TYPE_UNSPECIFIED":   0,
+		"ITEM_TYPE_ALOE":          1,
+		"ITEM_TYPE_SHIELD":        2,
+		"ITEM_TYPE_POTION_REFILL": 3,
+		"ITEM_TYPE_SPEED_SCROLL":  4,
+		"ITEM_TYPE_INVISIBILITY":  5,
 	}
 )
 
@@ -160,11 +309,11 @@ func (x ItemType) String() string {
 }
 
 func (ItemType) Descriptor() protoreflect.EnumDescriptor {
-	return file_multiplayer_v1_messages_proto_enumTypes[2].Descriptor()
+	return file_multiplayer_v1_messages_proto_enumTypes[4].Descriptor()
 }
 
 func (ItemType) Type() protoreflect.EnumType {
-	return &file_multiplayer_v1_messages_proto_enumTypes[2]
+	return &file_multiplayer_v1_messages_proto_enumTypes[4]
 }
 
 func (x ItemType) Number() protoreflect.EnumNumber {
@@ -173,7 +322,275 @@ func (x ItemType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ItemType.Descriptor instead.
 func (ItemType) EnumDescriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{2}
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+// Current phase of the match lifecycle
+type MatchPhase int32
+
+const (
+	MatchPhase_MATCH_PHASE_UNSPECIFIED MatchPhase = 0
+	MatchPhase_MATCH_PHASE_WAITING     MatchPhase = 1 // Waiting for enough players to start
+	MatchPhase_MATCH_PHASE_COUNTDOWN   MatchPhase = 2 // Match is about to start
+	MatchPhase_MATCH_PHASE_IN_PROGRESS MatchPhase = 3 // Round is live and scoring
+	MatchPhase_MATCH_PHASE_RESULTS     MatchPhase = 4 // Round is over, showing results before reset
+)
+
+// Enum value maps for MatchPhase.
+var (
+	MatchPhase_name = map[int32]string{
+		0: "MATCH_PHASE_UNSPECIFIED",
+		1: "MATCH_PHASE_WAITING",
+		2: "MATCH_PHASE_COUNTDOWN",
+		3: "MATCH_PHASE_IN_PROGRESS",
+		4: "MATCH_PHASE_RESULTS",
+	}
+	MatchPhase_value = map[string]int32{
+		"MATCH_PHASE_UNSPECIFIED": 0,
+		"MATCH_PHASE_WAITING":     1,
+		"MATCH_PHASE_COUNTDOWN":   2,
+		"MATCH_PHASE_IN_PROGRESS": 3,
+		"MATCH_PHASE_RESULTS":     4,
+	}
+)
+
+func (x MatchPhase) Enum() *MatchPhase {
+	p := new(MatchPhase)
+	*p = x
+	return p
+}
+
+func (x MatchPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[5].Descriptor()
+}
+
+func (MatchPhase) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[5]
+}
+
+func (x MatchPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchPhase.Descriptor instead.
+func (MatchPhase) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+// Why a round ended
+type MatchEndReason int32
+
+const (
+	MatchEndReason_MATCH_END_REASON_UNSPECIFIED MatchEndReason = 0
+	MatchEndReason_MATCH_END_REASON_TIME_LIMIT  MatchEndReason = 1
+	MatchEndReason_MATCH_END_REASON_SCORE_LIMIT MatchEndReason = 2
+	MatchEndReason_MATCH_END_REASON_OBJECTIVE   MatchEndReason = 3 // The game mode's win condition was met
+)
+
+// Enum value maps for MatchEndReason.
+var (
+	MatchEndReason_name = map[int32]string{
+		0: "MATCH_END_REASON_UNSPECIFIED",
+		1: "MATCH_END_REASON_TIME_LIMIT",
+		2: "MATCH_END_REASON_SCORE_LIMIT",
+		3: "MATCH_END_REASON_OBJECTIVE",
+	}
+	MatchEndReason_value = map[string]int32{
+		"MATCH_END_REASON_UNSPECIFIED": 0,
+		"MATCH_END_REASON_TIME_LIMIT":  1,
+		"MATCH_END_REASON_SCORE_LIMIT": 2,
+		"MATCH_END_REASON_OBJECTIVE":   3,
+	}
+)
+
+func (x MatchEndReason) Enum() *MatchEndReason {
+	p := new(MatchEndReason)
+	*p = x
+	return p
+}
+
+func (x MatchEndReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchEndReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[6].Descriptor()
+}
+
+func (MatchEndReason) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[6]
+}
+
+func (x MatchEndReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchEndReason.Descriptor instead.
+func (MatchEndReason) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+type GameMode int32
+
+const (
+	GameMode_GAME_MODE_UNSPECIFIED      GameMode = 0
+	GameMode_GAME_MODE_FREE_FOR_ALL     GameMode = 1
+	GameMode_GAME_MODE_FREEZE_TAG       GameMode = 2 // Teams win by freezing every opponent at once
+	GameMode_GAME_MODE_CAPTURE_THE_FLAG GameMode = 3 // Teams score by bringing the enemy flag to their base
+	GameMode_GAME_MODE_KING_OF_THE_HILL GameMode = 4 // Score by holding a control zone that moves periodically
+	GameMode_GAME_MODE_BATTLE_ROYALE    GameMode = 5 // A shrinking safe zone, last player standing wins
+)
+
+// Enum value maps for GameMode.
+var (
+	GameMode_name = map[int32]string{
+		0: "GAME_MODE_UNSPECIFIED",
+		1: "GAME_MODE_FREE_FOR_ALL",
+		2: "GAME_MODE_FREEZE_TAG",
+		3: "GAME_MODE_CAPTURE_THE_FLAG",
+		4: "GAME_MODE_KING_OF_THE_HILL",
+		5: "GAME_MODE_BATTLE_ROYALE",
+	}
+	GameMode_value = map[string]int32{
+		"GAME_MODE_UNSPECIFIED":      0,
+		"GAME_MODE_FREE_FOR_ALL":     1,
+		"GAME_MODE_FREEZE_TAG":       2,
+		"GAME_MODE_CAPTURE_THE_FLAG": 3,
+		"GAME_MODE_KING_OF_THE_HILL": 4,
+		"GAME_MODE_BATTLE_ROYALE":    5,
+	}
+)
+
+func (x GameMode) Enum() *GameMode {
+	p := new(GameMode)
+	*p = x
+	return p
+}
+
+func (x GameMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[7].Descriptor()
+}
+
+func (GameMode) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[7]
+}
+
+func (x GameMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameMode.Descriptor instead.
+func (GameMode) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+type ZoneShape int32
+
+const (
+	ZoneShape_ZONE_SHAPE_UNSPECIFIED ZoneShape = 0
+	ZoneShape_ZONE_SHAPE_CIRCLE      ZoneShape = 1
+	ZoneShape_ZONE_SHAPE_RECTANGLE   ZoneShape = 2
+)
+
+// Enum value maps for ZoneShape.
+var (
+	ZoneShape_name = map[int32]string{
+		0: "ZONE_SHAPE_UNSPECIFIED",
+		1: "ZONE_SHAPE_CIRCLE",
+		2: "ZONE_SHAPE_RECTANGLE",
+	}
+	ZoneShape_value = map[string]int32{
+		"ZONE_SHAPE_UNSPECIFIED": 0,
+		"ZONE_SHAPE_CIRCLE":      1,
+		"ZONE_SHAPE_RECTANGLE":   2,
+	}
+)
+
+func (x ZoneShape) Enum() *ZoneShape {
+	p := new(ZoneShape)
+	*p = x
+	return p
+}
+
+func (x ZoneShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ZoneShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[8].Descriptor()
+}
+
+func (ZoneShape) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[8]
+}
+
+func (x ZoneShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ZoneShape.Descriptor instead.
+func (ZoneShape) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+type FlagStatus int32
+
+const (
+	FlagStatus_FLAG_STATUS_UNSPECIFIED FlagStatus = 0
+	FlagStatus_FLAG_STATUS_AT_BASE     FlagStatus = 1
+	FlagStatus_FLAG_STATUS_CARRIED     FlagStatus = 2
+	FlagStatus_FLAG_STATUS_DROPPED     FlagStatus = 3 // Returns to base at returns_at_ms unless picked up first
+)
+
+// Enum value maps for FlagStatus.
+var (
+	FlagStatus_name = map[int32]string{
+		0: "FLAG_STATUS_UNSPECIFIED",
+		1: "FLAG_STATUS_AT_BASE",
+		2: "FLAG_STATUS_CARRIED",
+		3: "FLAG_STATUS_DROPPED",
+	}
+	FlagStatus_value = map[string]int32{
+		"FLAG_STATUS_UNSPECIFIED": 0,
+		"FLAG_STATUS_AT_BASE":     1,
+		"FLAG_STATUS_CARRIED":     2,
+		"FLAG_STATUS_DROPPED":     3,
+	}
+)
+
+func (x FlagStatus) Enum() *FlagStatus {
+	p := new(FlagStatus)
+	*p = x
+	return p
+}
+
+func (x FlagStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_messages_proto_enumTypes[9].Descriptor()
+}
+
+func (FlagStatus) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_messages_proto_enumTypes[9]
+}
+
+func (x FlagStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagStatus.Descriptor instead.
+func (FlagStatus) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{9}
 }
 
 // The wrapper for all incoming/outgoing WebSocket messages
@@ -187,6 +604,11 @@ type GameMessage struct {
 	//	*GameMessage_GameState
 	//	*GameMessage_ChatAnnouncement
 	//	*GameMessage_LobbyState
+	//	*GameMessage_MatchResults
+	//	*GameMessage_SnapshotAck
+	//	*GameMessage_MinimapState
+	//	*GameMessage_TimeSync
+	//	*GameMessage_SpectatorFollow
 	Payload       isGameMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -281,6 +703,51 @@ func (x *GameMessage) GetLobbyState() *LobbyState {
 	return nil
 }
 
+func (x *GameMessage) GetMatchResults() *MatchResults {
+	if x != nil {
+		if x, ok := x.Payload.(*GameMessage_MatchResults); ok {
+			return x.MatchResults
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetSnapshotAck() *SnapshotAck {
+	if x != nil {
+		if x, ok := x.Payload.(*GameMessage_SnapshotAck); ok {
+			return x.SnapshotAck
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetMinimapState() *MinimapState {
+	if x != nil {
+		if x, ok := x.Payload.(*GameMessage_MinimapState); ok {
+			return x.MinimapState
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetTimeSync() *TimeSync {
+	if x != nil {
+		if x, ok := x.Payload.(*GameMessage_TimeSync); ok {
+			return x.TimeSync
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetSpectatorFollow() *SpectatorFollow {
+	if x != nil {
+		if x, ok := x.Payload.(*GameMessage_SpectatorFollow); ok {
+			return x.SpectatorFollow
+		}
+	}
+	return nil
+}
+
 type isGameMessage_Payload interface {
 	isGameMessage_Payload()
 }
@@ -305,6 +772,26 @@ type GameMessage_LobbyState struct {
 	LobbyState *LobbyState `protobuf:"bytes,6,opt,name=lobby_state,json=lobbyState,proto3,oneof"`
 }
 
+type GameMessage_MatchResults struct {
+	MatchResults *MatchResults `protobuf:"bytes,7,opt,name=match_results,json=matchResults,proto3,oneof"`
+}
+
+type GameMessage_SnapshotAck struct {
+	SnapshotAck *SnapshotAck `protobuf:"bytes,8,opt,name=snapshot_ack,json=snapshotAck,proto3,oneof"`
+}
+
+type GameMessage_MinimapState struct {
+	MinimapState *MinimapState `protobuf:"bytes,9,opt,name=minimap_state,json=minimapState,proto3,oneof"`
+}
+
+type GameMessage_TimeSync struct {
+	TimeSync *TimeSync `protobuf:"bytes,10,opt,name=time_sync,json=timeSync,proto3,oneof"`
+}
+
+type GameMessage_SpectatorFollow struct {
+	SpectatorFollow *SpectatorFollow `protobuf:"bytes,11,opt,name=spectator_follow,json=spectatorFollow,proto3,oneof"`
+}
+
 func (*GameMessage_ChatMessage) isGameMessage_Payload() {}
 
 func (*GameMessage_PlayerEvent) isGameMessage_Payload() {}
@@ -315,31 +802,41 @@ func (*GameMessage_ChatAnnouncement) isGameMessage_Payload() {}
 
 func (*GameMessage_LobbyState) isGameMessage_Payload() {}
 
-// Chat from a player
-type ChatMessage struct {
+func (*GameMessage_MatchResults) isGameMessage_Payload() {}
+
+func (*GameMessage_SnapshotAck) isGameMessage_Payload() {}
+
+func (*GameMessage_MinimapState) isGameMessage_Payload() {}
+
+func (*GameMessage_TimeSync) isGameMessage_Payload() {}
+
+func (*GameMessage_SpectatorFollow) isGameMessage_Payload() {}
+
+// Server-side wrapper for client messages relayed between game servers.
+// The sender is taken from the authenticated connection, never from the client payload.
+type Envelope struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SenderId      *ID                    `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
 	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
-	SentAtUnix    int64                  `protobuf:"varint,4,opt,name=sent_at_unix,json=sentAtUnix,proto3" json:"sent_at_unix,omitempty"`
+	Message       *GameMessage           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
+func (x *Envelope) Reset() {
+	*x = Envelope{}
 	mi := &file_multiplayer_v1_messages_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatMessage) String() string {
+func (x *Envelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*Envelope) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+func (x *Envelope) ProtoReflect() protoreflect.Message {
 	mi := &file_multiplayer_v1_messages_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,30 +848,92 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
 	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ChatMessage) GetSenderId() *ID {
+func (x *Envelope) GetSenderId() *ID {
 	if x != nil {
 		return x.SenderId
 	}
 	return nil
 }
 
-func (x *ChatMessage) GetSenderName() string {
+func (x *Envelope) GetSenderName() string {
 	if x != nil {
 		return x.SenderName
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetText() string {
+func (x *Envelope) GetMessage() *GameMessage {
 	if x != nil {
-		return x.Text
+		return x.Message
 	}
-	return ""
+	return nil
+}
+
+// Chat from a player
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      *ID                    `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,2,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	SentAtUnix    int64                  `protobuf:"varint,4,opt,name=sent_at_unix,json=sentAtUnix,proto3" json:"sent_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChatMessage) GetSenderId() *ID {
+	if x != nil {
+		return x.SenderId
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
 func (x *ChatMessage) GetSentAtUnix() int64 {
@@ -395,7 +954,7 @@ type Announcement struct {
 
 func (x *Announcement) Reset() {
 	*x = Announcement{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[2]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +966,7 @@ func (x *Announcement) String() string {
 func (*Announcement) ProtoMessage() {}
 
 func (x *Announcement) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[2]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +979,7 @@ func (x *Announcement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
 func (*Announcement) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{2}
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Announcement) GetText() string {
@@ -437,20 +996,34 @@ func (x *Announcement) GetSentAtUnix() int64 {
 	return 0
 }
 
-// Periodic snapshot of the entire game state (for syncing position drift)
+// Periodic snapshot of the game state (for syncing position drift), limited to
+// entities within the receiving player's view radius.
+// With baseline_id set it is a delta: only entities changed since that snapshot
+// are included, and entities gone since then are listed in the removed_* fields.
 type GameState struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Players        []*PlayerState         `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	Projectiles    []*ProjectileState     `protobuf:"bytes,2,rep,name=projectiles,proto3" json:"projectiles,omitempty"`
-	Items          []*ItemState           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	QuicksandEvent *QuicksandEvent        `protobuf:"bytes,4,opt,name=quicksand_event,json=quicksandEvent,proto3" json:"quicksand_event,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Players            []*PlayerState         `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Projectiles        []*ProjectileState     `protobuf:"bytes,2,rep,name=projectiles,proto3" json:"projectiles,omitempty"`
+	Items              []*ItemState           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	QuicksandEvent     *QuicksandEvent        `protobuf:"bytes,4,opt,name=quicksand_event,json=quicksandEvent,proto3" json:"quicksand_event,omitempty"` // In a delta, only present when it changed
+	Match              *MatchState            `protobuf:"bytes,5,opt,name=match,proto3" json:"match,omitempty"`
+	SnapshotId         int64                  `protobuf:"varint,6,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"` // Ack this with a SnapshotAck to receive deltas
+	BaselineId         int64                  `protobuf:"varint,7,opt,name=baseline_id,json=baselineId,proto3" json:"baseline_id,omitempty"` // Acked snapshot this delta applies to, 0 for a full snapshot
+	RemovedPlayers     []*ID                  `protobuf:"bytes,8,rep,name=removed_players,json=removedPlayers,proto3" json:"removed_players,omitempty"`
+	RemovedProjectiles []string               `protobuf:"bytes,9,rep,name=removed_projectiles,json=removedProjectiles,proto3" json:"removed_projectiles,omitempty"`
+	RemovedItems       []string               `protobuf:"bytes,10,rep,name=removed_items,json=removedItems,proto3" json:"removed_items,omitempty"`
+	QuicksandCleared   bool                   `protobuf:"varint,11,opt,name=quicksand_cleared,json=quicksandCleared,proto3" json:"quicksand_cleared,omitempty"`        // The quicksand event in the baseline has ended
+	Tick               int64                  `protobuf:"varint,12,opt,name=tick,proto3" json:"tick,omitempty"`                                                        // Server simulation tick this snapshot was taken on
+	ServerTimeMs       int64                  `protobuf:"varint,13,opt,name=server_time_ms,json=serverTimeMs,proto3" json:"server_time_ms,omitempty"`                  // Server clock (Unix ms) when this snapshot was taken
+	WorldEvents        []*WorldEvent          `protobuf:"bytes,14,rep,name=world_events,json=worldEvents,proto3" json:"world_events,omitempty"`                        // In a delta, only events that changed
+	RemovedWorldEvents []string               `protobuf:"bytes,15,rep,name=removed_world_events,json=removedWorldEvents,proto3" json:"removed_world_events,omitempty"` // Events in the baseline that have ended
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GameState) Reset() {
 	*x = GameState{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[3]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,78 +1035,1418 @@ func (x *GameState) String() string {
 func (*GameState) ProtoMessage() {}
 
 func (x *GameState) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[3]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameState) GetPlayers() []*PlayerState {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetProjectiles() []*ProjectileState {
+	if x != nil {
+		return x.Projectiles
+	}
+	return nil
+}
+
+func (x *GameState) GetItems() []*ItemState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GameState) GetQuicksandEvent() *QuicksandEvent {
+	if x != nil {
+		return x.QuicksandEvent
+	}
+	return nil
+}
+
+func (x *GameState) GetMatch() *MatchState {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *GameState) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *GameState) GetBaselineId() int64 {
+	if x != nil {
+		return x.BaselineId
+	}
+	return 0
+}
+
+func (x *GameState) GetRemovedPlayers() []*ID {
+	if x != nil {
+		return x.RemovedPlayers
+	}
+	return nil
+}
+
+func (x *GameState) GetRemovedProjectiles() []string {
+	if x != nil {
+		return x.RemovedProjectiles
+	}
+	return nil
+}
+
+func (x *GameState) GetRemovedItems() []string {
+	if x != nil {
+		return x.RemovedItems
+	}
+	return nil
+}
+
+func (x *GameState) GetQuicksandCleared() bool {
+	if x != nil {
+		return x.QuicksandCleared
+	}
+	return false
+}
+
+func (x *GameState) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameState) GetServerTimeMs() int64 {
+	if x != nil {
+		return x.ServerTimeMs
+	}
+	return 0
+}
+
+func (x *GameState) GetWorldEvents() []*WorldEvent {
+	if x != nil {
+		return x.WorldEvents
+	}
+	return nil
+}
+
+func (x *GameState) GetRemovedWorldEvents() []string {
+	if x != nil {
+		return x.RemovedWorldEvents
+	}
+	return nil
+}
+
+// Clock sync exchange. The client sends client_send_ms and the server replies right away
+// with the same message and its own times filled in. With t3 the client's receive time:
+//
+//	rtt    = (t3 - client_send_ms) - (server_send_ms - server_receive_ms)
+//	offset = ((server_receive_ms - client_send_ms) + (server_send_ms - t3)) / 2
+//
+// All server timestamps are Unix milliseconds on the server clock; add -offset for local time.
+type TimeSync struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientSendMs    int64                  `protobuf:"varint,1,opt,name=client_send_ms,json=clientSendMs,proto3" json:"client_send_ms,omitempty"`
+	ServerReceiveMs int64                  `protobuf:"varint,2,opt,name=server_receive_ms,json=serverReceiveMs,proto3" json:"server_receive_ms,omitempty"`
+	ServerSendMs    int64                  `protobuf:"varint,3,opt,name=server_send_ms,json=serverSendMs,proto3" json:"server_send_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TimeSync) Reset() {
+	*x = TimeSync{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSync) ProtoMessage() {}
+
+func (x *TimeSync) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSync.ProtoReflect.Descriptor instead.
+func (*TimeSync) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TimeSync) GetClientSendMs() int64 {
+	if x != nil {
+		return x.ClientSendMs
+	}
+	return 0
+}
+
+func (x *TimeSync) GetServerReceiveMs() int64 {
+	if x != nil {
+		return x.ServerReceiveMs
+	}
+	return 0
+}
+
+func (x *TimeSync) GetServerSendMs() int64 {
+	if x != nil {
+		return x.ServerSendMs
+	}
+	return 0
+}
+
+// Sent by the client after applying a GameState so the server can delta against it
+type SnapshotAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    int64                  `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotAck) Reset() {
+	*x = SnapshotAck{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAck) ProtoMessage() {}
+
+func (x *SnapshotAck) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAck.ProtoReflect.Descriptor instead.
+func (*SnapshotAck) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SnapshotAck) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+// Sent by a spectator to watch the game from a player's view
+type SpectatorFollow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      *ID                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Player to follow, unset for an overview of the whole map
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorFollow) Reset() {
+	*x = SpectatorFollow{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorFollow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorFollow) ProtoMessage() {}
+
+func (x *SpectatorFollow) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorFollow.ProtoReflect.Descriptor instead.
+func (*SpectatorFollow) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SpectatorFollow) GetPlayerId() *ID {
+	if x != nil {
+		return x.PlayerId
+	}
+	return nil
+}
+
+// Player details inside a GameState update.
+// is_frozen and the *_until fields mirror entries in effects for older clients.
+type PlayerState struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId            *ID                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Position            *Vector2               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	IsFrozen            bool                   `protobuf:"varint,3,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`           // Whether player is currently frozen/stunned
+	FrozenUntil         float32                `protobuf:"fixed32,4,opt,name=frozen_until,json=frozenUntil,proto3" json:"frozen_until,omitempty"` // Deprecated: use frozen_until_ms, Unix seconds lose precision in a float
+	AloeCount           int32                  `protobuf:"varint,5,opt,name=aloe_count,json=aloeCount,proto3" json:"aloe_count,omitempty"`
+	SpeedBoostUntil     float32                `protobuf:"fixed32,6,opt,name=speed_boost_until,json=speedBoostUntil,proto3" json:"speed_boost_until,omitempty"`         // Deprecated: use speed_boost_until_ms
+	LastProcessedInput  uint32                 `protobuf:"varint,7,opt,name=last_processed_input,json=lastProcessedInput,proto3" json:"last_processed_input,omitempty"` // Sequence of the owner's latest input applied by this tick
+	FrozenUntilMs       int64                  `protobuf:"varint,8,opt,name=frozen_until_ms,json=frozenUntilMs,proto3" json:"frozen_until_ms,omitempty"`                // Server time (Unix ms) when freeze ends, 0 if not frozen
+	SpeedBoostUntilMs   int64                  `protobuf:"varint,9,opt,name=speed_boost_until_ms,json=speedBoostUntilMs,proto3" json:"speed_boost_until_ms,omitempty"`  // Server time (Unix ms) when speed boost ends, 0 if none
+	Health              int32                  `protobuf:"varint,10,opt,name=health,proto3" json:"health,omitempty"`
+	MaxHealth           int32                  `protobuf:"varint,11,opt,name=max_health,json=maxHealth,proto3" json:"max_health,omitempty"`
+	IsEliminated        bool                   `protobuf:"varint,12,opt,name=is_eliminated,json=isEliminated,proto3" json:"is_eliminated,omitempty"`                        // Health reached zero, the player cannot move or act
+	RespawnAtMs         int64                  `protobuf:"varint,13,opt,name=respawn_at_ms,json=respawnAtMs,proto3" json:"respawn_at_ms,omitempty"`                         // Server time (Unix ms) an eliminated player respawns, 0 otherwise
+	InvulnerableUntilMs int64                  `protobuf:"varint,14,opt,name=invulnerable_until_ms,json=invulnerableUntilMs,proto3" json:"invulnerable_until_ms,omitempty"` // Server time (Unix ms) spawn protection ends, 0 if none
+	Cooldowns           []*AbilityCooldown     `protobuf:"bytes,15,rep,name=cooldowns,proto3" json:"cooldowns,omitempty"`                                                   // Abilities still cooling down, ready ones are omitted
+	Effects             []*StatusEffect        `protobuf:"bytes,16,rep,name=effects,proto3" json:"effects,omitempty"`                                                       // Every active status effect, ordered by type
+	Team                int32                  `protobuf:"varint,17,opt,name=team,proto3" json:"team,omitempty"`                                                            // Team number starting at 1, 0 in free-for-all
+	ThawProgress        float32                `protobuf:"fixed32,18,opt,name=thaw_progress,json=thawProgress,proto3" json:"thaw_progress,omitempty"`                       // 0-1 while teammates are thawing this frozen player in freeze tag
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PlayerState) Reset() {
+	*x = PlayerState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerState) ProtoMessage() {}
+
+func (x *PlayerState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
+func (*PlayerState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerState) GetPlayerId() *ID {
+	if x != nil {
+		return x.PlayerId
+	}
+	return nil
+}
+
+func (x *PlayerState) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerState) GetIsFrozen() bool {
+	if x != nil {
+		return x.IsFrozen
+	}
+	return false
+}
+
+func (x *PlayerState) GetFrozenUntil() float32 {
+	if x != nil {
+		return x.FrozenUntil
+	}
+	return 0
+}
+
+func (x *PlayerState) GetAloeCount() int32 {
+	if x != nil {
+		return x.AloeCount
+	}
+	return 0
+}
+
+func (x *PlayerState) GetSpeedBoostUntil() float32 {
+	if x != nil {
+		return x.SpeedBoostUntil
+	}
+	return 0
+}
+
+func (x *PlayerState) GetLastProcessedInput() uint32 {
+	if x != nil {
+		return x.LastProcessedInput
+	}
+	return 0
+}
+
+func (x *PlayerState) GetFrozenUntilMs() int64 {
+	if x != nil {
+		return x.FrozenUntilMs
+	}
+	return 0
+}
+
+func (x *PlayerState) GetSpeedBoostUntilMs() int64 {
+	if x != nil {
+		return x.SpeedBoostUntilMs
+	}
+	return 0
+}
+
+func (x *PlayerState) GetHealth() int32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *PlayerState) GetMaxHealth() int32 {
+	if x != nil {
+		return x.MaxHealth
+	}
+	return 0
+}
+
+func (x *PlayerState) GetIsEliminated() bool {
+	if x != nil {
+		return x.IsEliminated
+	}
+	return false
+}
+
+func (x *PlayerState) GetRespawnAtMs() int64 {
+	if x != nil {
+		return x.RespawnAtMs
+	}
+	return 0
+}
+
+func (x *PlayerState) GetInvulnerableUntilMs() int64 {
+	if x != nil {
+		return x.InvulnerableUntilMs
+	}
+	return 0
+}
+
+func (x *PlayerState) GetCooldowns() []*AbilityCooldown {
+	if x != nil {
+		return x.Cooldowns
+	}
+	return nil
+}
+
+func (x *PlayerState) GetEffects() []*StatusEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+func (x *PlayerState) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerState) GetThawProgress() float32 {
+	if x != nil {
+		return x.ThawProgress
+	}
+	return 0
+}
+
+// A timed status effect on a player
+type StatusEffect struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            StatusEffectType       `protobuf:"varint,1,opt,name=type,proto3,enum=multiplayer.v1.StatusEffectType" json:"type,omitempty"`
+	ExpiresAtMs     int64                  `protobuf:"varint,2,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"` // Server time (Unix ms) the effect ends
+	Stacks          int32                  `protobuf:"varint,3,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	SpeedMultiplier float32                `protobuf:"fixed32,4,opt,name=speed_multiplier,json=speedMultiplier,proto3" json:"speed_multiplier,omitempty"` // Applied to movement speed, 0 means rooted
+	BlocksActions   bool                   `protobuf:"varint,5,opt,name=blocks_actions,json=blocksActions,proto3" json:"blocks_actions,omitempty"`        // The player cannot use abilities while this is active
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatusEffect) Reset() {
+	*x = StatusEffect{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEffect) ProtoMessage() {}
+
+func (x *StatusEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEffect.ProtoReflect.Descriptor instead.
+func (*StatusEffect) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StatusEffect) GetType() StatusEffectType {
+	if x != nil {
+		return x.Type
+	}
+	return StatusEffectType_STATUS_EFFECT_TYPE_UNSPECIFIED
+}
+
+func (x *StatusEffect) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetStacks() int32 {
+	if x != nil {
+		return x.Stacks
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetSpeedMultiplier() float32 {
+	if x != nil {
+		return x.SpeedMultiplier
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetBlocksActions() bool {
+	if x != nil {
+		return x.BlocksActions
+	}
+	return false
+}
+
+// Time left before a player can use an ability again
+type AbilityCooldown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        ActionType             `protobuf:"varint,1,opt,name=action,proto3,enum=multiplayer.v1.ActionType" json:"action,omitempty"`
+	RemainingMs   int64                  `protobuf:"varint,2,opt,name=remaining_ms,json=remainingMs,proto3" json:"remaining_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbilityCooldown) Reset() {
+	*x = AbilityCooldown{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbilityCooldown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbilityCooldown) ProtoMessage() {}
+
+func (x *AbilityCooldown) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbilityCooldown.ProtoReflect.Descriptor instead.
+func (*AbilityCooldown) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AbilityCooldown) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *AbilityCooldown) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+// Projectile state for syncing across clients
+type ProjectileState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectileId  string                 `protobuf:"bytes,1,opt,name=projectile_id,json=projectileId,proto3" json:"projectile_id,omitempty"`
+	Type          ProjectileType         `protobuf:"varint,2,opt,name=type,proto3,enum=multiplayer.v1.ProjectileType" json:"type,omitempty"`
+	Position      *Vector2               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Target        *Vector2               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`                  // Target position the projectile is heading toward
+	OwnerId       *ID                    `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Player who fired the projectile
+	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                 // Whether projectile is still in flight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectileState) Reset() {
+	*x = ProjectileState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectileState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectileState) ProtoMessage() {}
+
+func (x *ProjectileState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectileState.ProtoReflect.Descriptor instead.
+func (*ProjectileState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProjectileState) GetProjectileId() string {
+	if x != nil {
+		return x.ProjectileId
+	}
+	return ""
+}
+
+func (x *ProjectileState) GetType() ProjectileType {
+	if x != nil {
+		return x.Type
+	}
+	return ProjectileType_PROJECTILE_TYPE_UNSPECIFIED
+}
+
+func (x *ProjectileState) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ProjectileState) GetTarget() *Vector2 {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ProjectileState) GetOwnerId() *ID {
+	if x != nil {
+		return x.OwnerId
+	}
+	return nil
+}
+
+func (x *ProjectileState) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ItemState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Type          ItemType               `protobuf:"varint,2,opt,name=type,proto3,enum=multiplayer.v1.ItemType" json:"type,omitempty"`
+	Position      *Vector2               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemState) Reset() {
+	*x = ItemState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemState) ProtoMessage() {}
+
+func (x *ItemState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemState.ProtoReflect.Descriptor instead.
+func (*ItemState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ItemState) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemState) GetType() ItemType {
+	if x != nil {
+		return x.Type
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ItemState) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ItemState) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Low-rate coarse positions of every player for the minimap.
+// GameState only carries entities within the client's view radius.
+type MinimapState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Markers       []*MinimapMarker       `protobuf:"bytes,1,rep,name=markers,proto3" json:"markers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MinimapState) Reset() {
+	*x = MinimapState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinimapState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinimapState) ProtoMessage() {}
+
+func (x *MinimapState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinimapState.ProtoReflect.Descriptor instead.
+func (*MinimapState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MinimapState) GetMarkers() []*MinimapMarker {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
+type MinimapMarker struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      *ID                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Tile          *TileCoord             `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"` // Tile the player is on, not their exact position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MinimapMarker) Reset() {
+	*x = MinimapMarker{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MinimapMarker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinimapMarker) ProtoMessage() {}
+
+func (x *MinimapMarker) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinimapMarker.ProtoReflect.Descriptor instead.
+func (*MinimapMarker) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MinimapMarker) GetPlayerId() *ID {
+	if x != nil {
+		return x.PlayerId
+	}
+	return nil
+}
+
+func (x *MinimapMarker) GetTile() *TileCoord {
+	if x != nil {
+		return x.Tile
+	}
+	return nil
+}
+
+type TileCoord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TileCoord) Reset() {
+	*x = TileCoord{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TileCoord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileCoord) ProtoMessage() {}
+
+func (x *TileCoord) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileCoord.ProtoReflect.Descriptor instead.
+func (*TileCoord) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TileCoord) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *TileCoord) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// Mirrors the quicksand entry in GameState.world_events for older clients
+type QuicksandEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tiles         []*TileCoord           `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	ExpiresAt     float32                `protobuf:"fixed32,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Deprecated: use expires_at_ms
+	TileId        int32                  `protobuf:"varint,3,opt,name=tile_id,json=tileId,proto3" json:"tile_id,omitempty"`
+	ExpiresAtMs   int64                  `protobuf:"varint,4,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"` // Server time (Unix ms) when the event ends
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuicksandEvent) Reset() {
+	*x = QuicksandEvent{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuicksandEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuicksandEvent) ProtoMessage() {}
+
+func (x *QuicksandEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuicksandEvent.ProtoReflect.Descriptor instead.
+func (*QuicksandEvent) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuicksandEvent) GetTiles() []*TileCoord {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *QuicksandEvent) GetExpiresAt() float32 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *QuicksandEvent) GetTileId() int32 {
+	if x != nil {
+		return x.TileId
+	}
+	return 0
+}
+
+func (x *QuicksandEvent) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+// A meteor from a meteor shower. It freezes anyone inside its radius from impact until it fades.
+type Meteor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *Vector2               `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Radius        float32                `protobuf:"fixed32,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	ImpactAtMs    int64                  `protobuf:"varint,3,opt,name=impact_at_ms,json=impactAtMs,proto3" json:"impact_at_ms,omitempty"` // Server time (Unix ms) the meteor lands
+	FadesAtMs     int64                  `protobuf:"varint,4,opt,name=fades_at_ms,json=fadesAtMs,proto3" json:"fades_at_ms,omitempty"`    // Server time (Unix ms) the freeze zone disappears
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Meteor) Reset() {
+	*x = Meteor{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meteor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meteor) ProtoMessage() {}
+
+func (x *Meteor) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meteor.ProtoReflect.Descriptor instead.
+func (*Meteor) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Meteor) GetPosition() *Vector2 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Meteor) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Meteor) GetImpactAtMs() int64 {
+	if x != nil {
+		return x.ImpactAtMs
+	}
+	return 0
+}
+
+func (x *Meteor) GetFadesAtMs() int64 {
+	if x != nil {
+		return x.FadesAtMs
+	}
+	return 0
+}
+
+// A running world event. Only the fields for its kind are set.
+type WorldEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Kind          WorldEventKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=multiplayer.v1.WorldEventKind" json:"kind,omitempty"`
+	StartedAtMs   int64                  `protobuf:"varint,3,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"` // Server time (Unix ms)
+	EndsAtMs      int64                  `protobuf:"varint,4,opt,name=ends_at_ms,json=endsAtMs,proto3" json:"ends_at_ms,omitempty"`          // Server time (Unix ms)
+	Tiles         []*TileCoord           `protobuf:"bytes,5,rep,name=tiles,proto3" json:"tiles,omitempty"`                                   // Quicksand
+	TileId        int32                  `protobuf:"varint,6,opt,name=tile_id,json=tileId,proto3" json:"tile_id,omitempty"`                  // Quicksand
+	VisionScale   float32                `protobuf:"fixed32,7,opt,name=vision_scale,json=visionScale,proto3" json:"vision_scale,omitempty"`  // Sandstorm, fraction of the normal view radius
+	Meteors       []*Meteor              `protobuf:"bytes,8,rep,name=meteors,proto3" json:"meteors,omitempty"`                               // Meteor shower
+	Center        *Vector2               `protobuf:"bytes,9,opt,name=center,proto3" json:"center,omitempty"`                                 // Aloe bloom
+	Radius        float32                `protobuf:"fixed32,10,opt,name=radius,proto3" json:"radius,omitempty"`                              // Aloe bloom
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldEvent) Reset() {
+	*x = WorldEvent{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldEvent) ProtoMessage() {}
+
+func (x *WorldEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldEvent.ProtoReflect.Descriptor instead.
+func (*WorldEvent) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorldEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WorldEvent) GetKind() WorldEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WorldEventKind_WORLD_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *WorldEvent) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *WorldEvent) GetEndsAtMs() int64 {
+	if x != nil {
+		return x.EndsAtMs
+	}
+	return 0
+}
+
+func (x *WorldEvent) GetTiles() []*TileCoord {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *WorldEvent) GetTileId() int32 {
+	if x != nil {
+		return x.TileId
+	}
+	return 0
+}
+
+func (x *WorldEvent) GetVisionScale() float32 {
+	if x != nil {
+		return x.VisionScale
+	}
+	return 0
+}
+
+func (x *WorldEvent) GetMeteors() []*Meteor {
+	if x != nil {
+		return x.Meteors
+	}
+	return nil
+}
+
+func (x *WorldEvent) GetCenter() *Vector2 {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *WorldEvent) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// Match progress included in every GameState
+type MatchState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         MatchPhase             `protobuf:"varint,1,opt,name=phase,proto3,enum=multiplayer.v1.MatchPhase" json:"phase,omitempty"`
+	Round         int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	RemainingMs   int64                  `protobuf:"varint,3,opt,name=remaining_ms,json=remainingMs,proto3" json:"remaining_ms,omitempty"` // Time left in the current phase (0 while waiting)
+	Scores        []*PlayerScore         `protobuf:"bytes,4,rep,name=scores,proto3" json:"scores,omitempty"`
+	ScoreLimit    int32                  `protobuf:"varint,5,opt,name=score_limit,json=scoreLimit,proto3" json:"score_limit,omitempty"` // 0 when the mode has no score limit
+	Mode          GameMode               `protobuf:"varint,6,opt,name=mode,proto3,enum=multiplayer.v1.GameMode" json:"mode,omitempty"`
+	Teams         []*TeamScore           `protobuf:"bytes,7,rep,name=teams,proto3" json:"teams,omitempty"`                        // Empty in free-for-all
+	Flags         []*FlagState           `protobuf:"bytes,8,rep,name=flags,proto3" json:"flags,omitempty"`                        // Capture the flag only
+	Zone          *ControlZone           `protobuf:"bytes,9,opt,name=zone,proto3" json:"zone,omitempty"`                          // King of the hill only
+	SafeZone      *SafeZone              `protobuf:"bytes,10,opt,name=safe_zone,json=safeZone,proto3" json:"safe_zone,omitempty"` // Battle royale only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchState) Reset() {
+	*x = MatchState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchState) ProtoMessage() {}
+
+func (x *MatchState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchState.ProtoReflect.Descriptor instead.
+func (*MatchState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MatchState) GetPhase() MatchPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return MatchPhase_MATCH_PHASE_UNSPECIFIED
+}
+
+func (x *MatchState) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *MatchState) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+func (x *MatchState) GetScores() []*PlayerScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *MatchState) GetScoreLimit() int32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *MatchState) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_UNSPECIFIED
+}
+
+func (x *MatchState) GetTeams() []*TeamScore {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *MatchState) GetFlags() []*FlagState {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *MatchState) GetZone() *ControlZone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *MatchState) GetSafeZone() *SafeZone {
+	if x != nil {
+		return x.SafeZone
+	}
+	return nil
+}
+
+// The king of the hill control zone
+type ControlZone struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Shape          ZoneShape              `protobuf:"varint,1,opt,name=shape,proto3,enum=multiplayer.v1.ZoneShape" json:"shape,omitempty"`
+	Center         *Vector2               `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Radius         float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`                                      // Circle only
+	Width          float32                `protobuf:"fixed32,4,opt,name=width,proto3" json:"width,omitempty"`                                        // Rectangle only
+	Height         float32                `protobuf:"fixed32,5,opt,name=height,proto3" json:"height,omitempty"`                                      // Rectangle only
+	MovesAtMs      int64                  `protobuf:"varint,6,opt,name=moves_at_ms,json=movesAtMs,proto3" json:"moves_at_ms,omitempty"`              // Server time (Unix ms) the zone relocates
+	Contested      bool                   `protobuf:"varint,7,opt,name=contested,proto3" json:"contested,omitempty"`                                 // More than one player or team is inside, nobody scores
+	ControllerId   *ID                    `protobuf:"bytes,8,opt,name=controller_id,json=controllerId,proto3" json:"controller_id,omitempty"`        // Player scoring from the zone in free-for-all
+	ControllerTeam int32                  `protobuf:"varint,9,opt,name=controller_team,json=controllerTeam,proto3" json:"controller_team,omitempty"` // Team scoring from the zone in team play
+	HoldLimitMs    int64                  `protobuf:"varint,10,opt,name=hold_limit_ms,json=holdLimitMs,proto3" json:"hold_limit_ms,omitempty"`       // Hold time that wins the round
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ControlZone) Reset() {
+	*x = ControlZone{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlZone) ProtoMessage() {}
+
+func (x *ControlZone) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlZone.ProtoReflect.Descriptor instead.
+func (*ControlZone) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ControlZone) GetShape() ZoneShape {
+	if x != nil {
+		return x.Shape
+	}
+	return ZoneShape_ZONE_SHAPE_UNSPECIFIED
+}
+
+func (x *ControlZone) GetCenter() *Vector2 {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *ControlZone) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *ControlZone) GetWidth() float32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ControlZone) GetHeight() float32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Height
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{3}
+func (x *ControlZone) GetMovesAtMs() int64 {
+	if x != nil {
+		return x.MovesAtMs
+	}
+	return 0
 }
 
-func (x *GameState) GetPlayers() []*PlayerState {
+func (x *ControlZone) GetContested() bool {
 	if x != nil {
-		return x.Players
+		return x.Contested
 	}
-	return nil
+	return false
 }
 
-func (x *GameState) GetProjectiles() []*ProjectileState {
+func (x *ControlZone) GetControllerId() *ID {
 	if x != nil {
-		return x.Projectiles
+		return x.ControllerId
 	}
 	return nil
 }
 
-func (x *GameState) GetItems() []*ItemState {
+func (x *ControlZone) GetControllerTeam() int32 {
 	if x != nil {
-		return x.Items
+		return x.ControllerTeam
 	}
-	return nil
+	return 0
 }
 
-func (x *GameState) GetQuicksandEvent() *QuicksandEvent {
+func (x *ControlZone) GetHoldLimitMs() int64 {
 	if x != nil {
-		return x.QuicksandEvent
+		return x.HoldLimitMs
 	}
-	return nil
+	return 0
 }
 
-// Player details inside a GameState update
-type PlayerState struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId        *ID                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Position        *Vector2               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	IsFrozen        bool                   `protobuf:"varint,3,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`           // Whether player is currently frozen/stunned
-	FrozenUntil     float32                `protobuf:"fixed32,4,opt,name=frozen_until,json=frozenUntil,proto3" json:"frozen_until,omitempty"` // Server timestamp when freeze ends (for client-side prediction)
-	AloeCount       int32                  `protobuf:"varint,5,opt,name=aloe_count,json=aloeCount,proto3" json:"aloe_count,omitempty"`
-	SpeedBoostUntil float32                `protobuf:"fixed32,6,opt,name=speed_boost_until,json=speedBoostUntil,proto3" json:"speed_boost_until,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// The battle royale safe zone. It waits, then shrinks toward the next circle.
+type SafeZone struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Center         *Vector2               `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius         float32                `protobuf:"fixed32,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	NextCenter     *Vector2               `protobuf:"bytes,3,opt,name=next_center,json=nextCenter,proto3" json:"next_center,omitempty"`
+	NextRadius     float32                `protobuf:"fixed32,4,opt,name=next_radius,json=nextRadius,proto3" json:"next_radius,omitempty"`
+	Stage          int32                  `protobuf:"varint,5,opt,name=stage,proto3" json:"stage,omitempty"`                                             // Current stage, starting at 1
+	Stages         int32                  `protobuf:"varint,6,opt,name=stages,proto3" json:"stages,omitempty"`                                           // Total number of stages
+	ShrinksAtMs    int64                  `protobuf:"varint,7,opt,name=shrinks_at_ms,json=shrinksAtMs,proto3" json:"shrinks_at_ms,omitempty"`            // Server time (Unix ms) the next shrink starts
+	ShrinkEndsAtMs int64                  `protobuf:"varint,8,opt,name=shrink_ends_at_ms,json=shrinkEndsAtMs,proto3" json:"shrink_ends_at_ms,omitempty"` // Server time (Unix ms) the zone reaches the next circle
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PlayerState) Reset() {
-	*x = PlayerState{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[4]
+func (x *SafeZone) Reset() {
+	*x = SafeZone{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerState) String() string {
+func (x *SafeZone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerState) ProtoMessage() {}
+func (*SafeZone) ProtoMessage() {}
 
-func (x *PlayerState) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[4]
+func (x *SafeZone) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,81 +2457,95 @@ func (x *PlayerState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
-func (*PlayerState) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SafeZone.ProtoReflect.Descriptor instead.
+func (*SafeZone) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PlayerState) GetPlayerId() *ID {
+func (x *SafeZone) GetCenter() *Vector2 {
 	if x != nil {
-		return x.PlayerId
+		return x.Center
 	}
 	return nil
 }
 
-func (x *PlayerState) GetPosition() *Vector2 {
+func (x *SafeZone) GetRadius() float32 {
 	if x != nil {
-		return x.Position
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SafeZone) GetNextCenter() *Vector2 {
+	if x != nil {
+		return x.NextCenter
 	}
 	return nil
 }
 
-func (x *PlayerState) GetIsFrozen() bool {
+func (x *SafeZone) GetNextRadius() float32 {
 	if x != nil {
-		return x.IsFrozen
+		return x.NextRadius
 	}
-	return false
+	return 0
 }
 
-func (x *PlayerState) GetFrozenUntil() float32 {
+func (x *SafeZone) GetStage() int32 {
 	if x != nil {
-		return x.FrozenUntil
+		return x.Stage
 	}
 	return 0
 }
 
-func (x *PlayerState) GetAloeCount() int32 {
+func (x *SafeZone) GetStages() int32 {
 	if x != nil {
-		return x.AloeCount
+		return x.Stages
 	}
 	return 0
 }
 
-func (x *PlayerState) GetSpeedBoostUntil() float32 {
+func (x *SafeZone) GetShrinksAtMs() int64 {
 	if x != nil {
-		return x.SpeedBoostUntil
+		return x.ShrinksAtMs
 	}
 	return 0
 }
 
-// Projectile state for syncing across clients
-type ProjectileState struct {
+func (x *SafeZone) GetShrinkEndsAtMs() int64 {
+	if x != nil {
+		return x.ShrinkEndsAtMs
+	}
+	return 0
+}
+
+// A team's flag in capture the flag
+type FlagState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectileId  string                 `protobuf:"bytes,1,opt,name=projectile_id,json=projectileId,proto3" json:"projectile_id,omitempty"`
-	Type          ProjectileType         `protobuf:"varint,2,opt,name=type,proto3,enum=multiplayer.v1.ProjectileType" json:"type,omitempty"`
-	Position      *Vector2               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	Target        *Vector2               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`                  // Target position the projectile is heading toward
-	OwnerId       *ID                    `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // Player who fired the projectile
-	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                 // Whether projectile is still in flight
+	Team          int32                  `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"` // Team the flag belongs to
+	Status        FlagStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=multiplayer.v1.FlagStatus" json:"status,omitempty"`
+	Position      *Vector2               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"` // Follows the carrier while carried
+	Base          *Vector2               `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	CarrierId     *ID                    `protobuf:"bytes,5,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`          // Set while carried
+	ReturnsAtMs   int64                  `protobuf:"varint,6,opt,name=returns_at_ms,json=returnsAtMs,proto3" json:"returns_at_ms,omitempty"` // Server time (Unix ms) a dropped flag returns to base, 0 otherwise
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectileState) Reset() {
-	*x = ProjectileState{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[5]
+func (x *FlagState) Reset() {
+	*x = FlagState{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectileState) String() string {
+func (x *FlagState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectileState) ProtoMessage() {}
+func (*FlagState) ProtoMessage() {}
 
-func (x *ProjectileState) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[5]
+func (x *FlagState) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,78 +2556,81 @@ func (x *ProjectileState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectileState.ProtoReflect.Descriptor instead.
-func (*ProjectileState) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use FlagState.ProtoReflect.Descriptor instead.
+func (*FlagState) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ProjectileState) GetProjectileId() string {
+func (x *FlagState) GetTeam() int32 {
 	if x != nil {
-		return x.ProjectileId
+		return x.Team
 	}
-	return ""
+	return 0
 }
 
-func (x *ProjectileState) GetType() ProjectileType {
+func (x *FlagState) GetStatus() FlagStatus {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
-	return ProjectileType_PROJECTILE_TYPE_UNSPECIFIED
+	return FlagStatus_FLAG_STATUS_UNSPECIFIED
 }
 
-func (x *ProjectileState) GetPosition() *Vector2 {
+func (x *FlagState) GetPosition() *Vector2 {
 	if x != nil {
 		return x.Position
 	}
 	return nil
 }
 
-func (x *ProjectileState) GetTarget() *Vector2 {
+func (x *FlagState) GetBase() *Vector2 {
 	if x != nil {
-		return x.Target
+		return x.Base
 	}
 	return nil
 }
 
-func (x *ProjectileState) GetOwnerId() *ID {
+func (x *FlagState) GetCarrierId() *ID {
 	if x != nil {
-		return x.OwnerId
+		return x.CarrierId
 	}
 	return nil
 }
 
-func (x *ProjectileState) GetActive() bool {
+func (x *FlagState) GetReturnsAtMs() int64 {
 	if x != nil {
-		return x.Active
+		return x.ReturnsAtMs
 	}
-	return false
+	return 0
 }
 
-type ItemState struct {
+// Per-team totals for the current round
+type TeamScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Type          ItemType               `protobuf:"varint,2,opt,name=type,proto3,enum=multiplayer.v1.ItemType" json:"type,omitempty"`
-	Position      *Vector2               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Team          int32                  `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"` // Sum of the members' scores
+	Players       int32                  `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	Disabled      int32                  `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`           // Members currently frozen or eliminated
+	Captures      int32                  `protobuf:"varint,5,opt,name=captures,proto3" json:"captures,omitempty"`           // Enemy flags captured, capture the flag only
+	HillMs        int64                  `protobuf:"varint,6,opt,name=hill_ms,json=hillMs,proto3" json:"hill_ms,omitempty"` // Time the team has held the control zone, king of the hill only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ItemState) Reset() {
-	*x = ItemState{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[6]
+func (x *TeamScore) Reset() {
+	*x = TeamScore{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ItemState) String() string {
+func (x *TeamScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ItemState) ProtoMessage() {}
+func (*TeamScore) ProtoMessage() {}
 
-func (x *ItemState) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[6]
+func (x *TeamScore) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,62 +2641,84 @@ func (x *ItemState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ItemState.ProtoReflect.Descriptor instead.
-func (*ItemState) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TeamScore.ProtoReflect.Descriptor instead.
+func (*TeamScore) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ItemState) GetItemId() string {
+func (x *TeamScore) GetTeam() int32 {
 	if x != nil {
-		return x.ItemId
+		return x.Team
 	}
-	return ""
+	return 0
 }
 
-func (x *ItemState) GetType() ItemType {
+func (x *TeamScore) GetScore() int32 {
 	if x != nil {
-		return x.Type
+		return x.Score
 	}
-	return ItemType_ITEM_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ItemState) GetPosition() *Vector2 {
+func (x *TeamScore) GetPlayers() int32 {
 	if x != nil {
-		return x.Position
+		return x.Players
 	}
-	return nil
+	return 0
 }
 
-func (x *ItemState) GetActive() bool {
+func (x *TeamScore) GetDisabled() int32 {
 	if x != nil {
-		return x.Active
+		return x.Disabled
 	}
-	return false
+	return 0
 }
 
-type TileCoord struct {
+func (x *TeamScore) GetCaptures() int32 {
+	if x != nil {
+		return x.Captures
+	}
+	return 0
+}
+
+func (x *TeamScore) GetHillMs() int64 {
+	if x != nil {
+		return x.HillMs
+	}
+	return 0
+}
+
+// Per-player score for the current round
+type PlayerScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	PlayerId      *ID                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Freezes       int32                  `protobuf:"varint,4,opt,name=freezes,proto3" json:"freezes,omitempty"` // Opponents frozen by this player's potions
+	AloeCollected int32                  `protobuf:"varint,5,opt,name=aloe_collected,json=aloeCollected,proto3" json:"aloe_collected,omitempty"`
+	Eliminations  int32                  `protobuf:"varint,6,opt,name=eliminations,proto3" json:"eliminations,omitempty"`   // Opponents taken to zero health by this player
+	Thaws         int32                  `protobuf:"varint,7,opt,name=thaws,proto3" json:"thaws,omitempty"`                 // Frozen teammates thawed by this player
+	Captures      int32                  `protobuf:"varint,8,opt,name=captures,proto3" json:"captures,omitempty"`           // Enemy flags brought home by this player
+	HillMs        int64                  `protobuf:"varint,9,opt,name=hill_ms,json=hillMs,proto3" json:"hill_ms,omitempty"` // Time this player has held the control zone
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TileCoord) Reset() {
-	*x = TileCoord{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[7]
+func (x *PlayerScore) Reset() {
+	*x = PlayerScore{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TileCoord) String() string {
+func (x *PlayerScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TileCoord) ProtoMessage() {}
+func (*PlayerScore) ProtoMessage() {}
 
-func (x *TileCoord) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[7]
+func (x *PlayerScore) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,49 +2729,101 @@ func (x *TileCoord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TileCoord.ProtoReflect.Descriptor instead.
-func (*TileCoord) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PlayerScore.ProtoReflect.Descriptor instead.
+func (*PlayerScore) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TileCoord) GetX() int32 {
+func (x *PlayerScore) GetPlayerId() *ID {
 	if x != nil {
-		return x.X
+		return x.PlayerId
+	}
+	return nil
+}
+
+func (x *PlayerScore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
 	}
 	return 0
 }
 
-func (x *TileCoord) GetY() int32 {
+func (x *PlayerScore) GetFreezes() int32 {
 	if x != nil {
-		return x.Y
+		return x.Freezes
 	}
 	return 0
 }
 
-type QuicksandEvent struct {
+func (x *PlayerScore) GetAloeCollected() int32 {
+	if x != nil {
+		return x.AloeCollected
+	}
+	return 0
+}
+
+func (x *PlayerScore) GetEliminations() int32 {
+	if x != nil {
+		return x.Eliminations
+	}
+	return 0
+}
+
+func (x *PlayerScore) GetThaws() int32 {
+	if x != nil {
+		return x.Thaws
+	}
+	return 0
+}
+
+func (x *PlayerScore) GetCaptures() int32 {
+	if x != nil {
+		return x.Captures
+	}
+	return 0
+}
+
+func (x *PlayerScore) GetHillMs() int64 {
+	if x != nil {
+		return x.HillMs
+	}
+	return 0
+}
+
+// Sent once when a round ends
+type MatchResults struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tiles         []*TileCoord           `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"`
-	ExpiresAt     float32                `protobuf:"fixed32,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	TileId        int32                  `protobuf:"varint,3,opt,name=tile_id,json=tileId,proto3" json:"tile_id,omitempty"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Standings     []*PlayerScore         `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"` // Sorted by score, highest first
+	WinnerId      *ID                    `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Reason        MatchEndReason         `protobuf:"varint,4,opt,name=reason,proto3,enum=multiplayer.v1.MatchEndReason" json:"reason,omitempty"`
+	WinningTeam   int32                  `protobuf:"varint,5,opt,name=winning_team,json=winningTeam,proto3" json:"winning_team,omitempty"` // 0 outside team modes or when no team won
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuicksandEvent) Reset() {
-	*x = QuicksandEvent{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[8]
+func (x *MatchResults) Reset() {
+	*x = MatchResults{}
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuicksandEvent) String() string {
+func (x *MatchResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuicksandEvent) ProtoMessage() {}
+func (*MatchResults) ProtoMessage() {}
 
-func (x *QuicksandEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[8]
+func (x *MatchResults) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,28 +2834,42 @@ func (x *QuicksandEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuicksandEvent.ProtoReflect.Descriptor instead.
-func (*QuicksandEvent) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MatchResults.ProtoReflect.Descriptor instead.
+func (*MatchResults) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QuicksandEvent) GetTiles() []*TileCoord {
+func (x *MatchResults) GetRound() int32 {
 	if x != nil {
-		return x.Tiles
+		return x.Round
+	}
+	return 0
+}
+
+func (x *MatchResults) GetStandings() []*PlayerScore {
+	if x != nil {
+		return x.Standings
 	}
 	return nil
 }
 
-func (x *QuicksandEvent) GetExpiresAt() float32 {
+func (x *MatchResults) GetWinnerId() *ID {
 	if x != nil {
-		return x.ExpiresAt
+		return x.WinnerId
 	}
-	return 0
+	return nil
 }
 
-func (x *QuicksandEvent) GetTileId() int32 {
+func (x *MatchResults) GetReason() MatchEndReason {
 	if x != nil {
-		return x.TileId
+		return x.Reason
+	}
+	return MatchEndReason_MATCH_END_REASON_UNSPECIFIED
+}
+
+func (x *MatchResults) GetWinningTeam() int32 {
+	if x != nil {
+		return x.WinningTeam
 	}
 	return 0
 }
@@ -861,13 +2879,14 @@ type LobbyState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LobbyUsers    []*LobbyUser           `protobuf:"bytes,1,rep,name=lobby_users,json=lobbyUsers,proto3" json:"lobby_users,omitempty"` // All connected users (in lobby, not yet in game)
 	GameUsers     []*LobbyUser           `protobuf:"bytes,2,rep,name=game_users,json=gameUsers,proto3" json:"game_users,omitempty"`    // Users who have joined the game (readied up)
+	Spectators    []*LobbyUser           `protobuf:"bytes,3,rep,name=spectators,proto3" json:"spectators,omitempty"`                   // Connected spectators, never part of the game
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LobbyState) Reset() {
 	*x = LobbyState{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[9]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -879,7 +2898,7 @@ func (x *LobbyState) String() string {
 func (*LobbyState) ProtoMessage() {}
 
 func (x *LobbyState) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[9]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +2911,7 @@ func (x *LobbyState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyState.ProtoReflect.Descriptor instead.
 func (*LobbyState) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{9}
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LobbyState) GetLobbyUsers() []*LobbyUser {
@@ -909,19 +2928,27 @@ func (x *LobbyState) GetGameUsers() []*LobbyUser {
 	return nil
 }
 
+func (x *LobbyState) GetSpectators() []*LobbyUser {
+	if x != nil {
+		return x.Spectators
+	}
+	return nil
+}
+
 // User info for lobby display
 type LobbyUser struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        *ID                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	IsReady       bool                   `protobuf:"varint,3,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	Team          int32                  `protobuf:"varint,4,opt,name=team,proto3" json:"team,omitempty"` // Assigned when the user joins the game, 0 in free-for-all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LobbyUser) Reset() {
 	*x = LobbyUser{}
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[10]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -933,7 +2960,7 @@ func (x *LobbyUser) String() string {
 func (*LobbyUser) ProtoMessage() {}
 
 func (x *LobbyUser) ProtoReflect() protoreflect.Message {
-	mi := &file_multiplayer_v1_messages_proto_msgTypes[10]
+	mi := &file_multiplayer_v1_messages_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +2973,7 @@ func (x *LobbyUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyUser.ProtoReflect.Descriptor instead.
 func (*LobbyUser) Descriptor() ([]byte, []int) {
-	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{10}
+	return file_multiplayer_v1_messages_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LobbyUser) GetUserId() *ID {
@@ -970,11 +2997,18 @@ func (x *LobbyUser) GetIsReady() bool {
 	return false
 }
 
+func (x *LobbyUser) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
 var File_multiplayer_v1_messages_proto protoreflect.FileDescriptor
 
 const file_multiplayer_v1_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x1dmultiplayer/v1/messages.proto\x12\x0emultiplayer.v1\x1a\x1bmultiplayer/v1/common.proto\x1a\x1bmultiplayer/v1/player.proto\"\x99\x03\n" +
+	"\x1dmultiplayer/v1/messages.proto\x12\x0emultiplayer.v1\x1a\x1bmultiplayer/v1/common.proto\x1a\x1bmultiplayer/v1/player.proto\"\xec\x05\n" +
 	"\vGameMessage\x123\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1f.multiplayer.v1.GameMessageTypeR\x04type\x12@\n" +
 	"\fchat_message\x18\x02 \x01(\v2\x1b.multiplayer.v1.ChatMessageH\x00R\vchatMessage\x12@\n" +
@@ -983,8 +3017,19 @@ const file_multiplayer_v1_messages_proto_rawDesc = "" +
 	"game_state\x18\x04 \x01(\v2\x19.multiplayer.v1.GameStateH\x00R\tgameState\x12K\n" +
 	"\x11chat_announcement\x18\x05 \x01(\v2\x1c.multiplayer.v1.AnnouncementH\x00R\x10chatAnnouncement\x12=\n" +
 	"\vlobby_state\x18\x06 \x01(\v2\x1a.multiplayer.v1.LobbyStateH\x00R\n" +
-	"lobbyStateB\t\n" +
-	"\apayload\"\x95\x01\n" +
+	"lobbyState\x12C\n" +
+	"\rmatch_results\x18\a \x01(\v2\x1c.multiplayer.v1.MatchResultsH\x00R\fmatchResults\x12@\n" +
+	"\fsnapshot_ack\x18\b \x01(\v2\x1b.multiplayer.v1.SnapshotAckH\x00R\vsnapshotAck\x12C\n" +
+	"\rminimap_state\x18\t \x01(\v2\x1c.multiplayer.v1.MinimapStateH\x00R\fminimapState\x127\n" +
+	"\ttime_sync\x18\n" +
+	" \x01(\v2\x18.multiplayer.v1.TimeSyncH\x00R\btimeSync\x12L\n" +
+	"\x10spectator_follow\x18\v \x01(\v2\x1f.multiplayer.v1.SpectatorFollowH\x00R\x0fspectatorFollowB\t\n" +
+	"\apayload\"\x93\x01\n" +
+	"\bEnvelope\x12/\n" +
+	"\tsender_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x02 \x01(\tR\n" +
+	"senderName\x125\n" +
+	"\amessage\x18\x03 \x01(\v2\x1b.multiplayer.v1.GameMessageR\amessage\"\x95\x01\n" +
 	"\vChatMessage\x12/\n" +
 	"\tsender_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bsenderId\x12\x1f\n" +
 	"\vsender_name\x18\x02 \x01(\tR\n" +
@@ -995,12 +3040,35 @@ const file_multiplayer_v1_messages_proto_rawDesc = "" +
 	"\fAnnouncement\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12 \n" +
 	"\fsent_at_unix\x18\x02 \x01(\x03R\n" +
-	"sentAtUnix\"\xff\x01\n" +
+	"sentAtUnix\"\xde\x05\n" +
 	"\tGameState\x125\n" +
 	"\aplayers\x18\x01 \x03(\v2\x1b.multiplayer.v1.PlayerStateR\aplayers\x12A\n" +
 	"\vprojectiles\x18\x02 \x03(\v2\x1f.multiplayer.v1.ProjectileStateR\vprojectiles\x12/\n" +
 	"\x05items\x18\x03 \x03(\v2\x19.multiplayer.v1.ItemStateR\x05items\x12G\n" +
-	"\x0fquicksand_event\x18\x04 \x01(\v2\x1e.multiplayer.v1.QuicksandEventR\x0equicksandEvent\"\xfe\x01\n" +
+	"\x0fquicksand_event\x18\x04 \x01(\v2\x1e.multiplayer.v1.QuicksandEventR\x0equicksandEvent\x120\n" +
+	"\x05match\x18\x05 \x01(\v2\x1a.multiplayer.v1.MatchStateR\x05match\x12\x1f\n" +
+	"\vsnapshot_id\x18\x06 \x01(\x03R\n" +
+	"snapshotId\x12\x1f\n" +
+	"\vbaseline_id\x18\a \x01(\x03R\n" +
+	"baselineId\x12;\n" +
+	"\x0fremoved_players\x18\b \x03(\v2\x12.multiplayer.v1.IDR\x0eremovedPlayers\x12/\n" +
+	"\x13removed_projectiles\x18\t \x03(\tR\x12removedProjectiles\x12#\n" +
+	"\rremoved_items\x18\n" +
+	" \x03(\tR\fremovedItems\x12+\n" +
+	"\x11quicksand_cleared\x18\v \x01(\bR\x10quicksandCleared\x12\x12\n" +
+	"\x04tick\x18\f \x01(\x03R\x04tick\x12$\n" +
+	"\x0eserver_time_ms\x18\r \x01(\x03R\fserverTimeMs\x12=\n" +
+	"\fworld_events\x18\x0e \x03(\v2\x1a.multiplayer.v1.WorldEventR\vworldEvents\x120\n" +
+	"\x14removed_world_events\x18\x0f \x03(\tR\x12removedWorldEvents\"\x82\x01\n" +
+	"\bTimeSync\x12$\n" +
+	"\x0eclient_send_ms\x18\x01 \x01(\x03R\fclientSendMs\x12*\n" +
+	"\x11server_receive_ms\x18\x02 \x01(\x03R\x0fserverReceiveMs\x12$\n" +
+	"\x0eserver_send_ms\x18\x03 \x01(\x03R\fserverSendMs\".\n" +
+	"\vSnapshotAck\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\x03R\n" +
+	"snapshotId\"B\n" +
+	"\x0fSpectatorFollow\x12/\n" +
+	"\tplayer_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bplayerId\"\xed\x05\n" +
 	"\vPlayerState\x12/\n" +
 	"\tplayer_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bplayerId\x123\n" +
 	"\bposition\x18\x02 \x01(\v2\x17.multiplayer.v1.Vector2R\bposition\x12\x1b\n" +
@@ -1008,7 +3076,30 @@ const file_multiplayer_v1_messages_proto_rawDesc = "" +
 	"\ffrozen_until\x18\x04 \x01(\x02R\vfrozenUntil\x12\x1d\n" +
 	"\n" +
 	"aloe_count\x18\x05 \x01(\x05R\taloeCount\x12*\n" +
-	"\x11speed_boost_until\x18\x06 \x01(\x02R\x0fspeedBoostUntil\"\x97\x02\n" +
+	"\x11speed_boost_until\x18\x06 \x01(\x02R\x0fspeedBoostUntil\x120\n" +
+	"\x14last_processed_input\x18\a \x01(\rR\x12lastProcessedInput\x12&\n" +
+	"\x0ffrozen_until_ms\x18\b \x01(\x03R\rfrozenUntilMs\x12/\n" +
+	"\x14speed_boost_until_ms\x18\t \x01(\x03R\x11speedBoostUntilMs\x12\x16\n" +
+	"\x06health\x18\n" +
+	" \x01(\x05R\x06health\x12\x1d\n" +
+	"\n" +
+	"max_health\x18\v \x01(\x05R\tmaxHealth\x12#\n" +
+	"\ris_eliminated\x18\f \x01(\bR\fisEliminated\x12\"\n" +
+	"\rrespawn_at_ms\x18\r \x01(\x03R\vrespawnAtMs\x122\n" +
+	"\x15invulnerable_until_ms\x18\x0e \x01(\x03R\x13invulnerableUntilMs\x12=\n" +
+	"\tcooldowns\x18\x0f \x03(\v2\x1f.multiplayer.v1.AbilityCooldownR\tcooldowns\x126\n" +
+	"\aeffects\x18\x10 \x03(\v2\x1c.multiplayer.v1.StatusEffectR\aeffects\x12\x12\n" +
+	"\x04team\x18\x11 \x01(\x05R\x04team\x12#\n" +
+	"\rthaw_progress\x18\x12 \x01(\x02R\fthawProgress\"\xd2\x01\n" +
+	"\fStatusEffect\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .multiplayer.v1.StatusEffectTypeR\x04type\x12\"\n" +
+	"\rexpires_at_ms\x18\x02 \x01(\x03R\vexpiresAtMs\x12\x16\n" +
+	"\x06stacks\x18\x03 \x01(\x05R\x06stacks\x12)\n" +
+	"\x10speed_multiplier\x18\x04 \x01(\x02R\x0fspeedMultiplier\x12%\n" +
+	"\x0eblocks_actions\x18\x05 \x01(\bR\rblocksActions\"h\n" +
+	"\x0fAbilityCooldown\x122\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1a.multiplayer.v1.ActionTypeR\x06action\x12!\n" +
+	"\fremaining_ms\x18\x02 \x01(\x03R\vremainingMs\"\x97\x02\n" +
 	"\x0fProjectileState\x12#\n" +
 	"\rprojectile_id\x18\x01 \x01(\tR\fprojectileId\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.multiplayer.v1.ProjectileTypeR\x04type\x123\n" +
@@ -1020,39 +3111,192 @@ const file_multiplayer_v1_messages_proto_rawDesc = "" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12,\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x18.multiplayer.v1.ItemTypeR\x04type\x123\n" +
 	"\bposition\x18\x03 \x01(\v2\x17.multiplayer.v1.Vector2R\bposition\x12\x16\n" +
-	"\x06active\x18\x04 \x01(\bR\x06active\"'\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\"G\n" +
+	"\fMinimapState\x127\n" +
+	"\amarkers\x18\x01 \x03(\v2\x1d.multiplayer.v1.MinimapMarkerR\amarkers\"o\n" +
+	"\rMinimapMarker\x12/\n" +
+	"\tplayer_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bplayerId\x12-\n" +
+	"\x04tile\x18\x02 \x01(\v2\x19.multiplayer.v1.TileCoordR\x04tile\"'\n" +
 	"\tTileCoord\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
-	"\x01y\x18\x02 \x01(\x05R\x01y\"y\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"\x9d\x01\n" +
 	"\x0eQuicksandEvent\x12/\n" +
 	"\x05tiles\x18\x01 \x03(\v2\x19.multiplayer.v1.TileCoordR\x05tiles\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x02 \x01(\x02R\texpiresAt\x12\x17\n" +
-	"\atile_id\x18\x03 \x01(\x05R\x06tileId\"\x82\x01\n" +
+	"\atile_id\x18\x03 \x01(\x05R\x06tileId\x12\"\n" +
+	"\rexpires_at_ms\x18\x04 \x01(\x03R\vexpiresAtMs\"\x97\x01\n" +
+	"\x06Meteor\x123\n" +
+	"\bposition\x18\x01 \x01(\v2\x17.multiplayer.v1.Vector2R\bposition\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x02R\x06radius\x12 \n" +
+	"\fimpact_at_ms\x18\x03 \x01(\x03R\n" +
+	"impactAtMs\x12\x1e\n" +
+	"\vfades_at_ms\x18\x04 \x01(\x03R\tfadesAtMs\"\x85\x03\n" +
+	"\n" +
+	"WorldEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x122\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1e.multiplayer.v1.WorldEventKindR\x04kind\x12\"\n" +
+	"\rstarted_at_ms\x18\x03 \x01(\x03R\vstartedAtMs\x12\x1c\n" +
+	"\n" +
+	"ends_at_ms\x18\x04 \x01(\x03R\bendsAtMs\x12/\n" +
+	"\x05tiles\x18\x05 \x03(\v2\x19.multiplayer.v1.TileCoordR\x05tiles\x12\x17\n" +
+	"\atile_id\x18\x06 \x01(\x05R\x06tileId\x12!\n" +
+	"\fvision_scale\x18\a \x01(\x02R\vvisionScale\x120\n" +
+	"\ameteors\x18\b \x03(\v2\x16.multiplayer.v1.MeteorR\ameteors\x12/\n" +
+	"\x06center\x18\t \x01(\v2\x17.multiplayer.v1.Vector2R\x06center\x12\x16\n" +
+	"\x06radius\x18\n" +
+	" \x01(\x02R\x06radius\"\xc5\x03\n" +
+	"\n" +
+	"MatchState\x120\n" +
+	"\x05phase\x18\x01 \x01(\x0e2\x1a.multiplayer.v1.MatchPhaseR\x05phase\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12!\n" +
+	"\fremaining_ms\x18\x03 \x01(\x03R\vremainingMs\x123\n" +
+	"\x06scores\x18\x04 \x03(\v2\x1b.multiplayer.v1.PlayerScoreR\x06scores\x12\x1f\n" +
+	"\vscore_limit\x18\x05 \x01(\x05R\n" +
+	"scoreLimit\x12,\n" +
+	"\x04mode\x18\x06 \x01(\x0e2\x18.multiplayer.v1.GameModeR\x04mode\x12/\n" +
+	"\x05teams\x18\a \x03(\v2\x19.multiplayer.v1.TeamScoreR\x05teams\x12/\n" +
+	"\x05flags\x18\b \x03(\v2\x19.multiplayer.v1.FlagStateR\x05flags\x12/\n" +
+	"\x04zone\x18\t \x01(\v2\x1b.multiplayer.v1.ControlZoneR\x04zone\x125\n" +
+	"\tsafe_zone\x18\n" +
+	" \x01(\v2\x18.multiplayer.v1.SafeZoneR\bsafeZone\"\xf9\x02\n" +
+	"\vControlZone\x12/\n" +
+	"\x05shape\x18\x01 \x01(\x0e2\x19.multiplayer.v1.ZoneShapeR\x05shape\x12/\n" +
+	"\x06center\x18\x02 \x01(\v2\x17.multiplayer.v1.Vector2R\x06center\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x02R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x02R\x06height\x12\x1e\n" +
+	"\vmoves_at_ms\x18\x06 \x01(\x03R\tmovesAtMs\x12\x1c\n" +
+	"\tcontested\x18\a \x01(\bR\tcontested\x127\n" +
+	"\rcontroller_id\x18\b \x01(\v2\x12.multiplayer.v1.IDR\fcontrollerId\x12'\n" +
+	"\x0fcontroller_team\x18\t \x01(\x05R\x0econtrollerTeam\x12\"\n" +
+	"\rhold_limit_ms\x18\n" +
+	" \x01(\x03R\vholdLimitMs\"\xab\x02\n" +
+	"\bSafeZone\x12/\n" +
+	"\x06center\x18\x01 \x01(\v2\x17.multiplayer.v1.Vector2R\x06center\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x02R\x06radius\x128\n" +
+	"\vnext_center\x18\x03 \x01(\v2\x17.multiplayer.v1.Vector2R\n" +
+	"nextCenter\x12\x1f\n" +
+	"\vnext_radius\x18\x04 \x01(\x02R\n" +
+	"nextRadius\x12\x14\n" +
+	"\x05stage\x18\x05 \x01(\x05R\x05stage\x12\x16\n" +
+	"\x06stages\x18\x06 \x01(\x05R\x06stages\x12\"\n" +
+	"\rshrinks_at_ms\x18\a \x01(\x03R\vshrinksAtMs\x12)\n" +
+	"\x11shrink_ends_at_ms\x18\b \x01(\x03R\x0eshrinkEndsAtMs\"\x8c\x02\n" +
+	"\tFlagState\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\x05R\x04team\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.multiplayer.v1.FlagStatusR\x06status\x123\n" +
+	"\bposition\x18\x03 \x01(\v2\x17.multiplayer.v1.Vector2R\bposition\x12+\n" +
+	"\x04base\x18\x04 \x01(\v2\x17.multiplayer.v1.Vector2R\x04base\x121\n" +
+	"\n" +
+	"carrier_id\x18\x05 \x01(\v2\x12.multiplayer.v1.IDR\tcarrierId\x12\"\n" +
+	"\rreturns_at_ms\x18\x06 \x01(\x03R\vreturnsAtMs\"\xa0\x01\n" +
+	"\tTeamScore\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\x05R\x04team\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12\x18\n" +
+	"\aplayers\x18\x03 \x01(\x05R\aplayers\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\x05R\bdisabled\x12\x1a\n" +
+	"\bcaptures\x18\x05 \x01(\x05R\bcaptures\x12\x17\n" +
+	"\ahill_ms\x18\x06 \x01(\x03R\x06hillMs\"\x98\x02\n" +
+	"\vPlayerScore\x12/\n" +
+	"\tplayer_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\bplayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x18\n" +
+	"\afreezes\x18\x04 \x01(\x05R\afreezes\x12%\n" +
+	"\x0ealoe_collected\x18\x05 \x01(\x05R\raloeCollected\x12\"\n" +
+	"\feliminations\x18\x06 \x01(\x05R\feliminations\x12\x14\n" +
+	"\x05thaws\x18\a \x01(\x05R\x05thaws\x12\x1a\n" +
+	"\bcaptures\x18\b \x01(\x05R\bcaptures\x12\x17\n" +
+	"\ahill_ms\x18\t \x01(\x03R\x06hillMs\"\xeb\x01\n" +
+	"\fMatchResults\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x129\n" +
+	"\tstandings\x18\x02 \x03(\v2\x1b.multiplayer.v1.PlayerScoreR\tstandings\x12/\n" +
+	"\twinner_id\x18\x03 \x01(\v2\x12.multiplayer.v1.IDR\bwinnerId\x126\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x1e.multiplayer.v1.MatchEndReasonR\x06reason\x12!\n" +
+	"\fwinning_team\x18\x05 \x01(\x05R\vwinningTeam\"\xbd\x01\n" +
 	"\n" +
 	"LobbyState\x12:\n" +
 	"\vlobby_users\x18\x01 \x03(\v2\x19.multiplayer.v1.LobbyUserR\n" +
 	"lobbyUsers\x128\n" +
 	"\n" +
-	"game_users\x18\x02 \x03(\v2\x19.multiplayer.v1.LobbyUserR\tgameUsers\"g\n" +
+	"game_users\x18\x02 \x03(\v2\x19.multiplayer.v1.LobbyUserR\tgameUsers\x129\n" +
+	"\n" +
+	"spectators\x18\x03 \x03(\v2\x19.multiplayer.v1.LobbyUserR\n" +
+	"spectators\"{\n" +
 	"\tLobbyUser\x12+\n" +
 	"\auser_id\x18\x01 \x01(\v2\x12.multiplayer.v1.IDR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bis_ready\x18\x03 \x01(\bR\aisReady*\xe5\x01\n" +
+	"\bis_ready\x18\x03 \x01(\bR\aisReady\x12\x12\n" +
+	"\x04team\x18\x04 \x01(\x05R\x04team*\x94\x03\n" +
 	"\x0fGameMessageType\x12!\n" +
 	"\x1dGAME_MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eGAME_MESSAGE_TYPE_CHAT_MESSAGE\x10\x01\x12\"\n" +
 	"\x1eGAME_MESSAGE_TYPE_PLAYER_EVENT\x10\x02\x12 \n" +
 	"\x1cGAME_MESSAGE_TYPE_GAME_STATE\x10\x03\x12\"\n" +
 	"\x1eGAME_MESSAGE_TYPE_ANNOUNCEMENT\x10\x04\x12!\n" +
-	"\x1dGAME_MESSAGE_TYPE_LOBBY_STATE\x10\x05*r\n" +
+	"\x1dGAME_MESSAGE_TYPE_LOBBY_STATE\x10\x05\x12#\n" +
+	"\x1fGAME_MESSAGE_TYPE_MATCH_RESULTS\x10\x06\x12\"\n" +
+	"\x1eGAME_MESSAGE_TYPE_SNAPSHOT_ACK\x10\a\x12#\n" +
+	"\x1fGAME_MESSAGE_TYPE_MINIMAP_STATE\x10\b\x12\x1f\n" +
+	"\x1bGAME_MESSAGE_TYPE_TIME_SYNC\x10\t\x12\x1e\n" +
+	"\x1aGAME_MESSAGE_TYPE_SPECTATE\x10\n" +
+	"*\xd6\x02\n" +
+	"\x10StatusEffectType\x12\"\n" +
+	"\x1eSTATUS_EFFECT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19STATUS_EFFECT_TYPE_FROZEN\x10\x01\x12&\n" +
+	"\"STATUS_EFFECT_TYPE_FREEZE_IMMUNITY\x10\x02\x12\"\n" +
+	"\x1eSTATUS_EFFECT_TYPE_SPEED_BOOST\x10\x03\x12'\n" +
+	"#STATUS_EFFECT_TYPE_SPAWN_PROTECTION\x10\x04\x12\x1d\n" +
+	"\x19STATUS_EFFECT_TYPE_SHIELD\x10\x05\x12 \n" +
+	"\x1cSTATUS_EFFECT_TYPE_INVISIBLE\x10\x06\x12$\n" +
+	" STATUS_EFFECT_TYPE_CARRYING_FLAG\x10\a\x12#\n" +
+	"\x1fSTATUS_EFFECT_TYPE_OUTSIDE_ZONE\x10\b*\xb7\x01\n" +
+	"\x0eWorldEventKind\x12 \n" +
+	"\x1cWORLD_EVENT_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aWORLD_EVENT_KIND_QUICKSAND\x10\x01\x12\x1e\n" +
+	"\x1aWORLD_EVENT_KIND_SANDSTORM\x10\x02\x12\"\n" +
+	"\x1eWORLD_EVENT_KIND_METEOR_SHOWER\x10\x03\x12\x1f\n" +
+	"\x1bWORLD_EVENT_KIND_ALOE_BLOOM\x10\x04*r\n" +
 	"\x0eProjectileType\x12\x1f\n" +
 	"\x1bPROJECTILE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18PROJECTILE_TYPE_FIREBALL\x10\x01\x12!\n" +
-	"\x1dPROJECTILE_TYPE_FREEZE_POTION\x10\x02*9\n" +
+	"\x1dPROJECTILE_TYPE_FREEZE_POTION\x10\x02*\xa4\x01\n" +
 	"\bItemType\x12\x19\n" +
 	"\x15ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eITEM_TYPE_ALOE\x10\x01B\xc8\x01\n" +
+	"\x0eITEM_TYPE_ALOE\x10\x01\x12\x14\n" +
+	"\x10ITEM_TYPE_SHIELD\x10\x02\x12\x1b\n" +
+	"\x17ITEM_TYPE_POTION_REFILL\x10\x03\x12\x1a\n" +
+	"\x16ITEM_TYPE_SPEED_SCROLL\x10\x04\x12\x1a\n" +
+	"\x16ITEM_TYPE_INVISIBILITY\x10\x05*\x93\x01\n" +
+	"\n" +
+	"MatchPhase\x12\x1b\n" +
+	"\x17MATCH_PHASE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MATCH_PHASE_WAITING\x10\x01\x12\x19\n" +
+	"\x15MATCH_PHASE_COUNTDOWN\x10\x02\x12\x1b\n" +
+	"\x17MATCH_PHASE_IN_PROGRESS\x10\x03\x12\x17\n" +
+	"\x13MATCH_PHASE_RESULTS\x10\x04*\x95\x01\n" +
+	"\x0eMatchEndReason\x12 \n" +
+	"\x1cMATCH_END_REASON_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bMATCH_END_REASON_TIME_LIMIT\x10\x01\x12 \n" +
+	"\x1cMATCH_END_REASON_SCORE_LIMIT\x10\x02\x12\x1e\n" +
+	"\x1aMATCH_END_REASON_OBJECTIVE\x10\x03*\xb8\x01\n" +
+	"\bGameMode\x12\x19\n" +
+	"\x15GAME_MODE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16GAME_MODE_FREE_FOR_ALL\x10\x01\x12\x18\n" +
+	"\x14GAME_MODE_FREEZE_TAG\x10\x02\x12\x1e\n" +
+	"\x1aGAME_MODE_CAPTURE_THE_FLAG\x10\x03\x12\x1e\n" +
+	"\x1aGAME_MODE_KING_OF_THE_HILL\x10\x04\x12\x1b\n" +
+	"\x17GAME_MODE_BATTLE_ROYALE\x10\x05*X\n" +
+	"\tZoneShape\x12\x1a\n" +
+	"\x16ZONE_SHAPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ZONE_SHAPE_CIRCLE\x10\x01\x12\x18\n" +
+	"\x14ZONE_SHAPE_RECTANGLE\x10\x02*t\n" +
+	"\n" +
+	"FlagStatus\x12\x1b\n" +
+	"\x17FLAG_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13FLAG_STATUS_AT_BASE\x10\x01\x12\x17\n" +
+	"\x13FLAG_STATUS_CARRIED\x10\x02\x12\x17\n" +
+	"\x13FLAG_STATUS_DROPPED\x10\x03B\xc8\x01\n" +
 	"\x12com.multiplayer.v1B\rMessagesProtoP\x01ZJgithub.com/sonastea/WizardWarriors/common/gen/multiplayer/v1;multiplayerv1\xa2\x02\x03MXX\xaa\x02\x0eMultiplayer.V1\xca\x02\x0eMultiplayer\\V1\xe2\x02\x1aMultiplayer\\V1\\GPBMetadata\xea\x02\x0fMultiplayer::V1b\x06proto3"
 
 var (
@@ -1067,56 +3311,125 @@ func file_multiplayer_v1_messages_proto_rawDescGZIP() []byte {
 	return file_multiplayer_v1_messages_proto_rawDescData
 }
 
-var file_multiplayer_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_multiplayer_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_multiplayer_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_multiplayer_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_multiplayer_v1_messages_proto_goTypes = []any{
 	(GameMessageType)(0),    // 0: multiplayer.v1.GameMessageType
-	(ProjectileType)(0),     // 1: multiplayer.v1.ProjectileType
-	(ItemType)(0),           // 2: multiplayer.v1.ItemType
-	(*GameMessage)(nil),     // 3: multiplayer.v1.GameMessage
-	(*ChatMessage)(nil),     // 4: multiplayer.v1.ChatMessage
-	(*Announcement)(nil),    // 5: multiplayer.v1.Announcement
-	(*GameState)(nil),       // 6: multiplayer.v1.GameState
-	(*PlayerState)(nil),     // 7: multiplayer.v1.PlayerState
-	(*ProjectileState)(nil), // 8: multiplayer.v1.ProjectileState
-	(*ItemState)(nil),       // 9: multiplayer.v1.ItemState
-	(*TileCoord)(nil),       // 10: multiplayer.v1.TileCoord
-	(*QuicksandEvent)(nil),  // 11: multiplayer.v1.QuicksandEvent
-	(*LobbyState)(nil),      // 12: multiplayer.v1.LobbyState
-	(*LobbyUser)(nil),       // 13: multiplayer.v1.LobbyUser
-	(*PlayerEvent)(nil),     // 14: multiplayer.v1.PlayerEvent
-	(*ID)(nil),              // 15: multiplayer.v1.ID
-	(*Vector2)(nil),         // 16: multiplayer.v1.Vector2
+	(StatusEffectType)(0),   // 1: multiplayer.v1.StatusEffectType
+	(WorldEventKind)(0),     // 2: multiplayer.v1.WorldEventKind
+	(ProjectileType)(0),     // 3: multiplayer.v1.ProjectileType
+	(ItemType)(0),           // 4: multiplayer.v1.ItemType
+	(MatchPhase)(0),         // 5: multiplayer.v1.MatchPhase
+	(MatchEndReason)(0),     // 6: multiplayer.v1.MatchEndReason
+	(GameMode)(0),           // 7: multiplayer.v1.GameMode
+	(ZoneShape)(0),          // 8: multiplayer.v1.ZoneShape
+	(FlagStatus)(0),         // 9: multiplayer.v1.FlagStatus
+	(*GameMessage)(nil),     // 10: multiplayer.v1.GameMessage
+	(*Envelope)(nil),        // 11: multiplayer.v1.Envelope
+	(*ChatMessage)(nil),     // 12: multiplayer.v1.ChatMessage
+	(*Announcement)(nil),    // 13: multiplayer.v1.Announcement
+	(*GameState)(nil),       // 14: multiplayer.v1.GameState
+	(*TimeSync)(nil),        // 15: multiplayer.v1.TimeSync
+	(*SnapshotAck)(nil),     // 16: multiplayer.v1.SnapshotAck
+	(*SpectatorFollow)(nil), // 17: multiplayer.v1.SpectatorFollow
+	(*PlayerState)(nil),     // 18: multiplayer.v1.PlayerState
+	(*StatusEffect)(nil),    // 19: multiplayer.v1.StatusEffect
+	(*AbilityCooldown)(nil), // 20: multiplayer.v1.AbilityCooldown
+	(*ProjectileState)(nil), // 21: multiplayer.v1.ProjectileState
+	(*ItemState)(nil),       // 22: multiplayer.v1.ItemState
+	(*MinimapState)(nil),    // 23: multiplayer.v1.MinimapState
+	(*MinimapMarker)(nil),   // 24: multiplayer.v1.MinimapMarker
+	(*TileCoord)(nil),       // 25: multiplayer.v1.TileCoord
+	(*QuicksandEvent)(nil),  // 26: multiplayer.v1.QuicksandEvent
+	(*Meteor)(nil),          // 27: multiplayer.v1.Meteor
+	(*WorldEvent)(nil),      // 28: multiplayer.v1.WorldEvent
+	(*MatchState)(nil),      // 29: multiplayer.v1.MatchState
+	(*ControlZone)(nil),     // 30: multiplayer.v1.ControlZone
+	(*SafeZone)(nil),        // 31: multiplayer.v1.SafeZone
+	(*FlagState)(nil),       // 32: multiplayer.v1.FlagState
+	(*TeamScore)(nil),       // 33: multiplayer.v1.TeamScore
+	(*PlayerScore)(nil),     // 34: multiplayer.v1.PlayerScore
+	(*MatchResults)(nil),    // 35: multiplayer.v1.MatchResults
+	(*LobbyState)(nil),      // 36: multiplayer.v1.LobbyState
+	(*LobbyUser)(nil),       // 37: multiplayer.v1.LobbyUser
+	(*PlayerEvent)(nil),     // 38: multiplayer.v1.PlayerEvent
+	(*ID)(nil),              // 39: multiplayer.v1.ID
+	(*Vector2)(nil),         // 40: multiplayer.v1.Vector2
+	(ActionType)(0),         // 41: multiplayer.v1.ActionType
 }
 var file_multiplayer_v1_messages_proto_depIdxs = []int32{
 	0,  // 0: multiplayer.v1.GameMessage.type:type_name -> multiplayer.v1.GameMessageType
-	4,  // 1: multiplayer.v1.GameMessage.chat_message:type_name -> multiplayer.v1.ChatMessage
-	14, // 2: multiplayer.v1.GameMessage.player_event:type_name -> multiplayer.v1.PlayerEvent
-	6,  // 3: multiplayer.v1.GameMessage.game_state:type_name -> multiplayer.v1.GameState
-	5,  // 4: multiplayer.v1.GameMessage.chat_announcement:type_name -> multiplayer.v1.Announcement
-	12, // 5: multiplayer.v1.GameMessage.lobby_state:type_name -> multiplayer.v1.LobbyState
-	15, // 6: multiplayer.v1.ChatMessage.sender_id:type_name -> multiplayer.v1.ID
-	7,  // 7: multiplayer.v1.GameState.players:type_name -> multiplayer.v1.PlayerState
-	8,  // 8: multiplayer.v1.GameState.projectiles:type_name -> multiplayer.v1.ProjectileState
-	9,  // 9: multiplayer.v1.GameState.items:type_name -> multiplayer.v1.ItemState
-	11, // 10: multiplayer.v1.GameState.quicksand_event:type_name -> multiplayer.v1.QuicksandEvent
-	15, // 11: multiplayer.v1.PlayerState.player_id:type_name -> multiplayer.v1.ID
-	16, // 12: multiplayer.v1.PlayerState.position:type_name -> multiplayer.v1.Vector2
-	1,  // 13: multiplayer.v1.ProjectileState.type:type_name -> multiplayer.v1.ProjectileType
-	16, // 14: multiplayer.v1.ProjectileState.position:type_name -> multiplayer.v1.Vector2
-	16, // 15: multiplayer.v1.ProjectileState.target:type_name -> multiplayer.v1.Vector2
-	15, // 16: multiplayer.v1.ProjectileState.owner_id:type_name -> multiplayer.v1.ID
-	2,  // 17: multiplayer.v1.ItemState.type:type_name -> multiplayer.v1.ItemType
-	16, // 18: multiplayer.v1.ItemState.position:type_name -> multiplayer.v1.Vector2
-	10, // 19: multiplayer.v1.QuicksandEvent.tiles:type_name -> multiplayer.v1.TileCoord
-	13, // 20: multiplayer.v1.LobbyState.lobby_users:type_name -> multiplayer.v1.LobbyUser
-	13, // 21: multiplayer.v1.LobbyState.game_users:type_name -> multiplayer.v1.LobbyUser
-	15, // 22: multiplayer.v1.LobbyUser.user_id:type_name -> multiplayer.v1.ID
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	12, // 1: multiplayer.v1.GameMessage.chat_message:type_name -> multiplayer.v1.ChatMessage
+	38, // 2: multiplayer.v1.GameMessage.player_event:type_name -> multiplayer.v1.PlayerEvent
+	14, // 3: multiplayer.v1.GameMessage.game_state:type_name -> multiplayer.v1.GameState
+	13, // 4: multiplayer.v1.GameMessage.chat_announcement:type_name -> multiplayer.v1.Announcement
+	36, // 5: multiplayer.v1.GameMessage.lobby_state:type_name -> multiplayer.v1.LobbyState
+	35, // 6: multiplayer.v1.GameMessage.match_results:type_name -> multiplayer.v1.MatchResults
+	16, // 7: multiplayer.v1.GameMessage.snapshot_ack:type_name -> multiplayer.v1.SnapshotAck
+	23, // 8: multiplayer.v1.GameMessage.minimap_state:type_name -> multiplayer.v1.MinimapState
+	15, // 9: multiplayer.v1.GameMessage.time_sync:type_name -> multiplayer.v1.TimeSync
+	17, // 10: multiplayer.v1.GameMessage.spectator_follow:type_name -> multiplayer.v1.SpectatorFollow
+	39, // 11: multiplayer.v1.Envelope.sender_id:type_name -> multiplayer.v1.ID
+	10, // 12: multiplayer.v1.Envelope.message:type_name -> multiplayer.v1.GameMessage
+	39, // 13: multiplayer.v1.ChatMessage.sender_id:type_name -> multiplayer.v1.ID
+	18, // 14: multiplayer.v1.GameState.players:type_name -> multiplayer.v1.PlayerState
+	21, // 15: multiplayer.v1.GameState.projectiles:type_name -> multiplayer.v1.ProjectileState
+	22, // 16: multiplayer.v1.GameState.items:type_name -> multiplayer.v1.ItemState
+	26, // 17: multiplayer.v1.GameState.quicksand_event:type_name -> multiplayer.v1.QuicksandEvent
+	29, // 18: multiplayer.v1.GameState.match:type_name -> multiplayer.v1.MatchState
+	39, // 19: multiplayer.v1.GameState.removed_players:type_name -> multiplayer.v1.ID
+	28, // 20: multiplayer.v1.GameState.world_events:type_name -> multiplayer.v1.WorldEvent
+	39, // 21: multiplayer.v1.SpectatorFollow.player_id:type_name -> multiplayer.v1.ID
+	39, // 22: multiplayer.v1.PlayerState.player_id:type_name -> multiplayer.v1.ID
+	40, // 23: multiplayer.v1.PlayerState.position:type_name -> multiplayer.v1.Vector2
+	20, // 24: multiplayer.v1.PlayerState.cooldowns:type_name -> multiplayer.v1.AbilityCooldown
+	19, // 25: multiplayer.v1.PlayerState.effects:type_name -> multiplayer.v1.StatusEffect
+	1,  // 26: multiplayer.v1.StatusEffect.type:type_name -> multiplayer.v1.StatusEffectType
+	41, // 27: multiplayer.v1.AbilityCooldown.action:type_name -> multiplayer.v1.ActionType
+	3,  // 28: multiplayer.v1.ProjectileState.type:type_name -> multiplayer.v1.ProjectileType
+	40, // 29: multiplayer.v1.ProjectileState.position:type_name -> multiplayer.v1.Vector2
+	40, // 30: multiplayer.v1.ProjectileState.target:type_name -> multiplayer.v1.Vector2
+	39, // 31: multiplayer.v1.ProjectileState.owner_id:type_name -> multiplayer.v1.ID
+	4,  // 32: multiplayer.v1.ItemState.type:type_name -> multiplayer.v1.ItemType
+	40, // 33: multiplayer.v1.ItemState.position:type_name -> multiplayer.v1.Vector2
+	24, // 34: multiplayer.v1.MinimapState.markers:type_name -> multiplayer.v1.MinimapMarker
+	39, // 35: multiplayer.v1.MinimapMarker.player_id:type_name -> multiplayer.v1.ID
+	25, // 36: multiplayer.v1.MinimapMarker.tile:type_name -> multiplayer.v1.TileCoord
+	25, // 37: multiplayer.v1.QuicksandEvent.tiles:type_name -> multiplayer.v1.TileCoord
+	40, // 38: multiplayer.v1.Meteor.position:type_name -> multiplayer.v1.Vector2
+	2,  // 39: multiplayer.v1.WorldEvent.kind:type_name -> multiplayer.v1.WorldEventKind
+	25, // 40: multiplayer.v1.WorldEvent.tiles:type_name -> multiplayer.v1.TileCoord
+	27, // 41: multiplayer.v1.WorldEvent.meteors:type_name -> multiplayer.v1.Meteor
+	40, // 42: multiplayer.v1.WorldEvent.center:type_name -> multiplayer.v1.Vector2
+	5,  // 43: multiplayer.v1.MatchState.phase:type_name -> multiplayer.v1.MatchPhase
+	34, // 44: multiplayer.v1.MatchState.scores:type_name -> multiplayer.v1.PlayerScore
+	7,  // 45: multiplayer.v1.MatchState.mode:type_name -> multiplayer.v1.GameMode
+	33, // 46: multiplayer.v1.MatchState.teams:type_name -> multiplayer.v1.TeamScore
+	32, // 47: multiplayer.v1.MatchState.flags:type_name -> multiplayer.v1.FlagState
+	30, // 48: multiplayer.v1.MatchState.zone:type_name -> multiplayer.v1.ControlZone
+	31, // 49: multiplayer.v1.MatchState.safe_zone:type_name -> multiplayer.v1.SafeZone
+	8,  // 50: multiplayer.v1.ControlZone.shape:type_name -> multiplayer.v1.ZoneShape
+	40, // 51: multiplayer.v1.ControlZone.center:type_name -> multiplayer.v1.Vector2
+	39, // 52: multiplayer.v1.ControlZone.controller_id:type_name -> multiplayer.v1.ID
+	40, // 53: multiplayer.v1.SafeZone.center:type_name -> multiplayer.v1.Vector2
+	40, // 54: multiplayer.v1.SafeZone.next_center:type_name -> multiplayer.v1.Vector2
+	9,  // 55: multiplayer.v1.FlagState.status:type_name -> multiplayer.v1.FlagStatus
+	40, // 56: multiplayer.v1.FlagState.position:type_name -> multiplayer.v1.Vector2
+	40, // 57: multiplayer.v1.FlagState.base:type_name -> multiplayer.v1.Vector2
+	39, // 58: multiplayer.v1.FlagState.carrier_id:type_name -> multiplayer.v1.ID
+	39, // 59: multiplayer.v1.PlayerScore.player_id:type_name -> multiplayer.v1.ID
+	34, // 60: multiplayer.v1.MatchResults.standings:type_name -> multiplayer.v1.PlayerScore
+	39, // 61: multiplayer.v1.MatchResults.winner_id:type_name -> multiplayer.v1.ID
+	6,  // 62: multiplayer.v1.MatchResults.reason:type_name -> multiplayer.v1.MatchEndReason
+	37, // 63: multiplayer.v1.LobbyState.lobby_users:type_name -> multiplayer.v1.LobbyUser
+	37, // 64: multiplayer.v1.LobbyState.game_users:type_name -> multiplayer.v1.LobbyUser
+	37, // 65: multiplayer.v1.LobbyState.spectators:type_name -> multiplayer.v1.LobbyUser
+	39, // 66: multiplayer.v1.LobbyUser.user_id:type_name -> multiplayer.v1.ID
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_multiplayer_v1_messages_proto_init() }
@@ -1132,14 +3445,19 @@ func file_multiplayer_v1_messages_proto_init() {
 		(*GameMessage_GameState)(nil),
 		(*GameMessage_ChatAnnouncement)(nil),
 		(*GameMessage_LobbyState)(nil),
+		(*GameMessage_MatchResults)(nil),
+		(*GameMessage_SnapshotAck)(nil),
+		(*GameMessage_MinimapState)(nil),
+		(*GameMessage_TimeSync)(nil),
+		(*GameMessage_SpectatorFollow)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multiplayer_v1_messages_proto_rawDesc), len(file_multiplayer_v1_messages_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   11,
+			NumEnums:      10,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
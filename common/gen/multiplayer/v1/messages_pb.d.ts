@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
-import type { PlayerEvent } from "./player_pb";
+import type { PlayerEvent, ActionType } from "./player_pb";
 import type { ID, Vector2 } from "./common_pb";
 
 /**
@@ -56,6 +56,36 @@ export declare type GameMessage = Message<"multiplayer.v1.GameMessage"> & {
      */
     value: LobbyState;
     case: "lobbyState";
+  } | {
+    /**
+     * @generated from field: multiplayer.v1.MatchResults match_results = 7;
+     */
+    value: MatchResults;
+    case: "matchResults";
+  } | {
+    /**
+     * @generated from field: multiplayer.v1.SnapshotAck snapshot_ack = 8;
+     */
+    value: SnapshotAck;
+    case: "snapshotAck";
+  } | {
+    /**
+     * @generated from field: multiplayer.v1.MinimapState minimap_state = 9;
+     */
+    value: MinimapState;
+    case: "minimapState";
+  } | {
+    /**
+     * @generated from field: multiplayer.v1.TimeSync time_sync = 10;
+     */
+    value: TimeSync;
+    case: "timeSync";
+  } | {
+    /**
+     * @generated from field: multiplayer.v1.SpectatorFollow spectator_follow = 11;
+     */
+    value: SpectatorFollow;
+    case: "spectatorFollow";
   } | { case: undefined; value?: undefined };
 };
 
@@ -65,6 +95,35 @@ export declare type GameMessage = Message<"multiplayer.v1.GameMessage"> & {
  */
 export declare const GameMessageSchema: GenMessage<GameMessage>;
 
+/**
+ * Server-side wrapper for client messages relayed between game servers.
+ * The sender is taken from the authenticated connection, never from the client payload.
+ *
+ * @generated from message multiplayer.v1.Envelope
+ */
+export declare type Envelope = Message<"multiplayer.v1.Envelope"> & {
+  /**
+   * @generated from field: multiplayer.v1.ID sender_id = 1;
+   */
+  senderId?: ID;
+
+  /**
+   * @generated from field: string sender_name = 2;
+   */
+  senderName: string;
+
+  /**
+   * @generated from field: multiplayer.v1.GameMessage message = 3;
+   */
+  message?: GameMessage;
+};
+
+/**
+ * Describes the message multiplayer.v1.Envelope.
+ * Use `create(EnvelopeSchema)` to create a new message.
+ */
+export declare const EnvelopeSchema: GenMessage<Envelope>;
+
 /**
  * Chat from a player
  *
@@ -122,7 +181,10 @@ export declare type Announcement = Message<"multiplayer.v1.Announcement"> & {
 export declare const AnnouncementSchema: GenMessage<Announcement>;
 
 /**
- * Periodic snapshot of the entire game state (for syncing position drift)
+ * Periodic snapshot of the game state (for syncing position drift), limited to
+ * entities within the receiving player's view radius.
+ * With baseline_id set it is a delta: only entities changed since that snapshot
+ * are included, and entities gone since then are listed in the removed_* fields.
  *
  * @generated from message multiplayer.v1.GameState
  */
@@ -143,9 +205,80 @@ export declare type GameState = Message<"multiplayer.v1.GameState"> & {
   items: ItemState[];
 
   /**
+   * In a delta, only present when it changed
+   *
    * @generated from field: multiplayer.v1.QuicksandEvent quicksand_event = 4;
    */
   quicksandEvent?: QuicksandEvent;
+
+  /**
+   * @generated from field: multiplayer.v1.MatchState match = 5;
+   */
+  match?: MatchState;
+
+  /**
+   * Ack this with a SnapshotAck to receive deltas
+   *
+   * @generated from field: int64 snapshot_id = 6;
+   */
+  snapshotId: bigint;
+
+  /**
+   * Acked snapshot this delta applies to, 0 for a full snapshot
+   *
+   * @generated from field: int64 baseline_id = 7;
+   */
+  baselineId: bigint;
+
+  /**
+   * @generated from field: repeated multiplayer.v1.ID removed_players = 8;
+   */
+  removedPlayers: ID[];
+
+  /**
+   * @generated from field: repeated string removed_projectiles = 9;
+   */
+  removedProjectiles: string[];
+
+  /**
+   * @generated from field: repeated string removed_items = 10;
+   */
+  removedItems: string[];
+
+  /**
+   * The quicksand event in the baseline has ended
+   *
+   * @generated from field: bool quicksand_cleared = 11;
+   */
+  quicksandCleared: boolean;
+
+  /**
+   * Server simulation tick this snapshot was taken on
+   *
+   * @generated from field: int64 tick = 12;
+   */
+  tick: bigint;
+
+  /**
+   * Server clock (Unix ms) when this snapshot was taken
+   *
+   * @generated from field: int64 server_time_ms = 13;
+   */
+  serverTimeMs: bigint;
+
+  /**
+   * In a delta, only events that changed
+   *
+   * @generated from field: repeated multiplayer.v1.WorldEvent world_events = 14;
+   */
+  worldEvents: WorldEvent[];
+
+  /**
+   * Events in the baseline that have ended
+   *
+   * @generated from field: repeated string removed_world_events = 15;
+   */
+  removedWorldEvents: string[];
 };
 
 /**
@@ -155,7 +288,78 @@ export declare type GameState = Message<"multiplayer.v1.GameState"> & {
 export declare const GameStateSchema: GenMessage<GameState>;
 
 /**
- * Player details inside a GameState update
+ * Clock sync exchange. The client sends client_send_ms and the server replies right away
+ * with the same message and its own times filled in. With t3 the client's receive time:
+ *   rtt    = (t3 - client_send_ms) - (server_send_ms - server_receive_ms)
+ *   offset = ((server_receive_ms - client_send_ms) + (server_send_ms - t3)) / 2
+ * All server timestamps are Unix milliseconds on the server clock; add -offset for local time.
+ *
+ * @generated from message multiplayer.v1.TimeSync
+ */
+export declare type TimeSync = Message<"multiplayer.v1.TimeSync"> & {
+  /**
+   * @generated from field: int64 client_send_ms = 1;
+   */
+  clientSendMs: bigint;
+
+  /**
+   * @generated from field: int64 server_receive_ms = 2;
+   */
+  serverReceiveMs: bigint;
+
+  /**
+   * @generated from field: int64 server_send_ms = 3;
+   */
+  serverSendMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.TimeSync.
+ * Use `create(TimeSyncSchema)` to create a new message.
+ */
+export declare const TimeSyncSchema: GenMessage<TimeSync>;
+
+/**
+ * Sent by the client after applying a GameState so the server can delta against it
+ *
+ * @generated from message multiplayer.v1.SnapshotAck
+ */
+export declare type SnapshotAck = Message<"multiplayer.v1.SnapshotAck"> & {
+  /**
+   * @generated from field: int64 snapshot_id = 1;
+   */
+  snapshotId: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.SnapshotAck.
+ * Use `create(SnapshotAckSchema)` to create a new message.
+ */
+export declare const SnapshotAckSchema: GenMessage<SnapshotAck>;
+
+/**
+ * Sent by a spectator to watch the game from a player's view
+ *
+ * @generated from message multiplayer.v1.SpectatorFollow
+ */
+export declare type SpectatorFollow = Message<"multiplayer.v1.SpectatorFollow"> & {
+  /**
+   * Player to follow, unset for an overview of the whole map
+   *
+   * @generated from field: multiplayer.v1.ID player_id = 1;
+   */
+  playerId?: ID;
+};
+
+/**
+ * Describes the message multiplayer.v1.SpectatorFollow.
+ * Use `create(SpectatorFollowSchema)` to create a new message.
+ */
+export declare const SpectatorFollowSchema: GenMessage<SpectatorFollow>;
+
+/**
+ * Player details inside a GameState update.
+ * is_frozen and the *_until fields mirror entries in effects for older clients.
  *
  * @generated from message multiplayer.v1.PlayerState
  */
@@ -178,7 +382,7 @@ export declare type PlayerState = Message<"multiplayer.v1.PlayerState"> & {
   isFrozen: boolean;
 
   /**
-   * Server timestamp when freeze ends (for client-side prediction)
+   * Deprecated: use frozen_until_ms, Unix seconds lose precision in a float
    *
    * @generated from field: float frozen_until = 4;
    */
@@ -190,9 +394,91 @@ export declare type PlayerState = Message<"multiplayer.v1.PlayerState"> & {
   aloeCount: number;
 
   /**
+   * Deprecated: use speed_boost_until_ms
+   *
    * @generated from field: float speed_boost_until = 6;
    */
   speedBoostUntil: number;
+
+  /**
+   * Sequence of the owner's latest input applied by this tick
+   *
+   * @generated from field: uint32 last_processed_input = 7;
+   */
+  lastProcessedInput: number;
+
+  /**
+   * Server time (Unix ms) when freeze ends, 0 if not frozen
+   *
+   * @generated from field: int64 frozen_until_ms = 8;
+   */
+  frozenUntilMs: bigint;
+
+  /**
+   * Server time (Unix ms) when speed boost ends, 0 if none
+   *
+   * @generated from field: int64 speed_boost_until_ms = 9;
+   */
+  speedBoostUntilMs: bigint;
+
+  /**
+   * @generated from field: int32 health = 10;
+   */
+  health: number;
+
+  /**
+   * @generated from field: int32 max_health = 11;
+   */
+  maxHealth: number;
+
+  /**
+   * Health reached zero, the player cannot move or act
+   *
+   * @generated from field: bool is_eliminated = 12;
+   */
+  isEliminated: boolean;
+
+  /**
+   * Server time (Unix ms) an eliminated player respawns, 0 otherwise
+   *
+   * @generated from field: int64 respawn_at_ms = 13;
+   */
+  respawnAtMs: bigint;
+
+  /**
+   * Server time (Unix ms) spawn protection ends, 0 if none
+   *
+   * @generated from field: int64 invulnerable_until_ms = 14;
+   */
+  invulnerableUntilMs: bigint;
+
+  /**
+   * Abilities still cooling down, ready ones are omitted
+   *
+   * @generated from field: repeated multiplayer.v1.AbilityCooldown cooldowns = 15;
+   */
+  cooldowns: AbilityCooldown[];
+
+  /**
+   * Every active status effect, ordered by type
+   *
+   * @generated from field: repeated multiplayer.v1.StatusEffect effects = 16;
+   */
+  effects: StatusEffect[];
+
+  /**
+   * Team number starting at 1, 0 in free-for-all
+   *
+   * @generated from field: int32 team = 17;
+   */
+  team: number;
+
+  /**
+   * 0-1 while teammates are thawing this frozen player in freeze tag
+   *
+   * @generated from field: float thaw_progress = 18;
+   */
+  thawProgress: number;
 };
 
 /**
@@ -201,6 +487,73 @@ export declare type PlayerState = Message<"multiplayer.v1.PlayerState"> & {
  */
 export declare const PlayerStateSchema: GenMessage<PlayerState>;
 
+/**
+ * A timed status effect on a player
+ *
+ * @generated from message multiplayer.v1.StatusEffect
+ */
+export declare type StatusEffect = Message<"multiplayer.v1.StatusEffect"> & {
+  /**
+   * @generated from field: multiplayer.v1.StatusEffectType type = 1;
+   */
+  type: StatusEffectType;
+
+  /**
+   * Server time (Unix ms) the effect ends
+   *
+   * @generated from field: int64 expires_at_ms = 2;
+   */
+  expiresAtMs: bigint;
+
+  /**
+   * @generated from field: int32 stacks = 3;
+   */
+  stacks: number;
+
+  /**
+   * Applied to movement speed, 0 means rooted
+   *
+   * @generated from field: float speed_multiplier = 4;
+   */
+  speedMultiplier: number;
+
+  /**
+   * The player cannot use abilities while this is active
+   *
+   * @generated from field: bool blocks_actions = 5;
+   */
+  blocksActions: boolean;
+};
+
+/**
+ * Describes the message multiplayer.v1.StatusEffect.
+ * Use `create(StatusEffectSchema)` to create a new message.
+ */
+export declare const StatusEffectSchema: GenMessage<StatusEffect>;
+
+/**
+ * Time left before a player can use an ability again
+ *
+ * @generated from message multiplayer.v1.AbilityCooldown
+ */
+export declare type AbilityCooldown = Message<"multiplayer.v1.AbilityCooldown"> & {
+  /**
+   * @generated from field: multiplayer.v1.ActionType action = 1;
+   */
+  action: ActionType;
+
+  /**
+   * @generated from field: int64 remaining_ms = 2;
+   */
+  remainingMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.AbilityCooldown.
+ * Use `create(AbilityCooldownSchema)` to create a new message.
+ */
+export declare const AbilityCooldownSchema: GenMessage<AbilityCooldown>;
+
 /**
  * Projectile state for syncing across clients
  *
@@ -281,6 +634,48 @@ export declare type ItemState = Message<"multiplayer.v1.ItemState"> & {
  */
 export declare const ItemStateSchema: GenMessage<ItemState>;
 
+/**
+ * Low-rate coarse positions of every player for the minimap.
+ * GameState only carries entities within the client's view radius.
+ *
+ * @generated from message multiplayer.v1.MinimapState
+ */
+export declare type MinimapState = Message<"multiplayer.v1.MinimapState"> & {
+  /**
+   * @generated from field: repeated multiplayer.v1.MinimapMarker markers = 1;
+   */
+  markers: MinimapMarker[];
+};
+
+/**
+ * Describes the message multiplayer.v1.MinimapState.
+ * Use `create(MinimapStateSchema)` to create a new message.
+ */
+export declare const MinimapStateSchema: GenMessage<MinimapState>;
+
+/**
+ * @generated from message multiplayer.v1.MinimapMarker
+ */
+export declare type MinimapMarker = Message<"multiplayer.v1.MinimapMarker"> & {
+  /**
+   * @generated from field: multiplayer.v1.ID player_id = 1;
+   */
+  playerId?: ID;
+
+  /**
+   * Tile the player is on, not their exact position
+   *
+   * @generated from field: multiplayer.v1.TileCoord tile = 2;
+   */
+  tile?: TileCoord;
+};
+
+/**
+ * Describes the message multiplayer.v1.MinimapMarker.
+ * Use `create(MinimapMarkerSchema)` to create a new message.
+ */
+export declare const MinimapMarkerSchema: GenMessage<MinimapMarker>;
+
 /**
  * @generated from message multiplayer.v1.TileCoord
  */
@@ -303,6 +698,8 @@ export declare type TileCoord = Message<"multiplayer.v1.TileCoord"> & {
 export declare const TileCoordSchema: GenMessage<TileCoord>;
 
 /**
+ * Mirrors the quicksand entry in GameState.world_events for older clients
+ *
  * @generated from message multiplayer.v1.QuicksandEvent
  */
 export declare type QuicksandEvent = Message<"multiplayer.v1.QuicksandEvent"> & {
@@ -312,6 +709,8 @@ export declare type QuicksandEvent = Message<"multiplayer.v1.QuicksandEvent"> &
   tiles: TileCoord[];
 
   /**
+   * Deprecated: use expires_at_ms
+   *
    * @generated from field: float expires_at = 2;
    */
   expiresAt: number;
@@ -320,6 +719,13 @@ export declare type QuicksandEvent = Message<"multiplayer.v1.QuicksandEvent"> &
    * @generated from field: int32 tile_id = 3;
    */
   tileId: number;
+
+  /**
+   * Server time (Unix ms) when the event ends
+   *
+   * @generated from field: int64 expires_at_ms = 4;
+   */
+  expiresAtMs: bigint;
 };
 
 /**
@@ -329,45 +735,595 @@ export declare type QuicksandEvent = Message<"multiplayer.v1.QuicksandEvent"> &
 export declare const QuicksandEventSchema: GenMessage<QuicksandEvent>;
 
 /**
- * Lobby state showing connected users and in-game players
+ * A meteor from a meteor shower. It freezes anyone inside its radius from impact until it fades.
  *
- * @generated from message multiplayer.v1.LobbyState
+ * @generated from message multiplayer.v1.Meteor
  */
-export declare type LobbyState = Message<"multiplayer.v1.LobbyState"> & {
+export declare type Meteor = Message<"multiplayer.v1.Meteor"> & {
   /**
-   * All connected users (in lobby, not yet in game)
+   * @generated from field: multiplayer.v1.Vector2 position = 1;
+   */
+  position?: Vector2;
+
+  /**
+   * @generated from field: float radius = 2;
+   */
+  radius: number;
+
+  /**
+   * Server time (Unix ms) the meteor lands
    *
-   * @generated from field: repeated multiplayer.v1.LobbyUser lobby_users = 1;
+   * @generated from field: int64 impact_at_ms = 3;
    */
-  lobbyUsers: LobbyUser[];
+  impactAtMs: bigint;
 
   /**
-   * Users who have joined the game (readied up)
+   * Server time (Unix ms) the freeze zone disappears
    *
-   * @generated from field: repeated multiplayer.v1.LobbyUser game_users = 2;
+   * @generated from field: int64 fades_at_ms = 4;
    */
-  gameUsers: LobbyUser[];
+  fadesAtMs: bigint;
 };
 
 /**
- * Describes the message multiplayer.v1.LobbyState.
- * Use `create(LobbyStateSchema)` to create a new message.
+ * Describes the message multiplayer.v1.Meteor.
+ * Use `create(MeteorSchema)` to create a new message.
  */
-export declare const LobbyStateSchema: GenMessage<LobbyState>;
+export declare const MeteorSchema: GenMessage<Meteor>;
 
 /**
- * User info for lobby display
+ * A running world event. Only the fields for its kind are set.
  *
- * @generated from message multiplayer.v1.LobbyUser
+ * @generated from message multiplayer.v1.WorldEvent
  */
-export declare type LobbyUser = Message<"multiplayer.v1.LobbyUser"> & {
+export declare type WorldEvent = Message<"multiplayer.v1.WorldEvent"> & {
   /**
-   * @generated from field: multiplayer.v1.ID user_id = 1;
+   * @generated from field: string event_id = 1;
    */
-  userId?: ID;
+  eventId: string;
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: multiplayer.v1.WorldEventKind kind = 2;
+   */
+  kind: WorldEventKind;
+
+  /**
+   * Server time (Unix ms)
+   *
+   * @generated from field: int64 started_at_ms = 3;
+   */
+  startedAtMs: bigint;
+
+  /**
+   * Server time (Unix ms)
+   *
+   * @generated from field: int64 ends_at_ms = 4;
+   */
+  endsAtMs: bigint;
+
+  /**
+   * Quicksand
+   *
+   * @generated from field: repeated multiplayer.v1.TileCoord tiles = 5;
+   */
+  tiles: TileCoord[];
+
+  /**
+   * Quicksand
+   *
+   * @generated from field: int32 tile_id = 6;
+   */
+  tileId: number;
+
+  /**
+   * Sandstorm, fraction of the normal view radius
+   *
+   * @generated from field: float vision_scale = 7;
+   */
+  visionScale: number;
+
+  /**
+   * Meteor shower
+   *
+   * @generated from field: repeated multiplayer.v1.Meteor meteors = 8;
+   */
+  meteors: Meteor[];
+
+  /**
+   * Aloe bloom
+   *
+   * @generated from field: multiplayer.v1.Vector2 center = 9;
+   */
+  center?: Vector2;
+
+  /**
+   * Aloe bloom
+   *
+   * @generated from field: float radius = 10;
+   */
+  radius: number;
+};
+
+/**
+ * Describes the message multiplayer.v1.WorldEvent.
+ * Use `create(WorldEventSchema)` to create a new message.
+ */
+export declare const WorldEventSchema: GenMessage<WorldEvent>;
+
+/**
+ * Match progress included in every GameState
+ *
+ * @generated from message multiplayer.v1.MatchState
+ */
+export declare type MatchState = Message<"multiplayer.v1.MatchState"> & {
+  /**
+   * @generated from field: multiplayer.v1.MatchPhase phase = 1;
+   */
+  phase: MatchPhase;
+
+  /**
+   * @generated from field: int32 round = 2;
+   */
+  round: number;
+
+  /**
+   * Time left in the current phase (0 while waiting)
+   *
+   * @generated from field: int64 remaining_ms = 3;
+   */
+  remainingMs: bigint;
+
+  /**
+   * @generated from field: repeated multiplayer.v1.PlayerScore scores = 4;
+   */
+  scores: PlayerScore[];
+
+  /**
+   * 0 when the mode has no score limit
+   *
+   * @generated from field: int32 score_limit = 5;
+   */
+  scoreLimit: number;
+
+  /**
+   * @generated from field: multiplayer.v1.GameMode mode = 6;
+   */
+  mode: GameMode;
+
+  /**
+   * Empty in free-for-all
+   *
+   * @generated from field: repeated multiplayer.v1.TeamScore teams = 7;
+   */
+  teams: TeamScore[];
+
+  /**
+   * Capture the flag only
+   *
+   * @generated from field: repeated multiplayer.v1.FlagState flags = 8;
+   */
+  flags: FlagState[];
+
+  /**
+   * King of the hill only
+   *
+   * @generated from field: multiplayer.v1.ControlZone zone = 9;
+   */
+  zone?: ControlZone;
+
+  /**
+   * Battle royale only
+   *
+   * @generated from field: multiplayer.v1.SafeZone safe_zone = 10;
+   */
+  safeZone?: SafeZone;
+};
+
+/**
+ * Describes the message multiplayer.v1.MatchState.
+ * Use `create(MatchStateSchema)` to create a new message.
+ */
+export declare const MatchStateSchema: GenMessage<MatchState>;
+
+/**
+ * The king of the hill control zone
+ *
+ * @generated from message multiplayer.v1.ControlZone
+ */
+export declare type ControlZone = Message<"multiplayer.v1.ControlZone"> & {
+  /**
+   * @generated from field: multiplayer.v1.ZoneShape shape = 1;
+   */
+  shape: ZoneShape;
+
+  /**
+   * @generated from field: multiplayer.v1.Vector2 center = 2;
+   */
+  center?: Vector2;
+
+  /**
+   * Circle only
+   *
+   * @generated from field: float radius = 3;
+   */
+  radius: number;
+
+  /**
+   * Rectangle only
+   *
+   * @generated from field: float width = 4;
+   */
+  width: number;
+
+  /**
+   * Rectangle only
+   *
+   * @generated from field: float height = 5;
+   */
+  height: number;
+
+  /**
+   * Server time (Unix ms) the zone relocates
+   *
+   * @generated from field: int64 moves_at_ms = 6;
+   */
+  movesAtMs: bigint;
+
+  /**
+   * More than one player or team is inside, nobody scores
+   *
+   * @generated from field: bool contested = 7;
+   */
+  contested: boolean;
+
+  /**
+   * Player scoring from the zone in free-for-all
+   *
+   * @generated from field: multiplayer.v1.ID controller_id = 8;
+   */
+  controllerId?: ID;
+
+  /**
+   * Team scoring from the zone in team play
+   *
+   * @generated from field: int32 controller_team = 9;
+   */
+  controllerTeam: number;
+
+  /**
+   * Hold time that wins the round
+   *
+   * @generated from field: int64 hold_limit_ms = 10;
+   */
+  holdLimitMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.ControlZone.
+ * Use `create(ControlZoneSchema)` to create a new message.
+ */
+export declare const ControlZoneSchema: GenMessage<ControlZone>;
+
+/**
+ * The battle royale safe zone. It waits, then shrinks toward the next circle.
+ *
+ * @generated from message multiplayer.v1.SafeZone
+ */
+export declare type SafeZone = Message<"multiplayer.v1.SafeZone"> & {
+  /**
+   * @generated from field: multiplayer.v1.Vector2 center = 1;
+   */
+  center?: Vector2;
+
+  /**
+   * @generated from field: float radius = 2;
+   */
+  radius: number;
+
+  /**
+   * @generated from field: multiplayer.v1.Vector2 next_center = 3;
+   */
+  nextCenter?: Vector2;
+
+  /**
+   * @generated from field: float next_radius = 4;
+   */
+  nextRadius: number;
+
+  /**
+   * Current stage, starting at 1
+   *
+   * @generated from field: int32 stage = 5;
+   */
+  stage: number;
+
+  /**
+   * Total number of stages
+   *
+   * @generated from field: int32 stages = 6;
+   */
+  stages: number;
+
+  /**
+   * Server time (Unix ms) the next shrink starts
+   *
+   * @generated from field: int64 shrinks_at_ms = 7;
+   */
+  shrinksAtMs: bigint;
+
+  /**
+   * Server time (Unix ms) the zone reaches the next circle
+   *
+   * @generated from field: int64 shrink_ends_at_ms = 8;
+   */
+  shrinkEndsAtMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.SafeZone.
+ * Use `create(SafeZoneSchema)` to create a new message.
+ */
+export declare const SafeZoneSchema: GenMessage<SafeZone>;
+
+/**
+ * A team's flag in capture the flag
+ *
+ * @generated from message multiplayer.v1.FlagState
+ */
+export declare type FlagState = Message<"multiplayer.v1.FlagState"> & {
+  /**
+   * Team the flag belongs to
+   *
+   * @generated from field: int32 team = 1;
+   */
+  team: number;
+
+  /**
+   * @generated from field: multiplayer.v1.FlagStatus status = 2;
+   */
+  status: FlagStatus;
+
+  /**
+   * Follows the carrier while carried
+   *
+   * @generated from field: multiplayer.v1.Vector2 position = 3;
+   */
+  position?: Vector2;
+
+  /**
+   * @generated from field: multiplayer.v1.Vector2 base = 4;
+   */
+  base?: Vector2;
+
+  /**
+   * Set while carried
+   *
+   * @generated from field: multiplayer.v1.ID carrier_id = 5;
+   */
+  carrierId?: ID;
+
+  /**
+   * Server time (Unix ms) a dropped flag returns to base, 0 otherwise
+   *
+   * @generated from field: int64 returns_at_ms = 6;
+   */
+  returnsAtMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.FlagState.
+ * Use `create(FlagStateSchema)` to create a new message.
+ */
+export declare const FlagStateSchema: GenMessage<FlagState>;
+
+/**
+ * Per-team totals for the current round
+ *
+ * @generated from message multiplayer.v1.TeamScore
+ */
+export declare type TeamScore = Message<"multiplayer.v1.TeamScore"> & {
+  /**
+   * @generated from field: int32 team = 1;
+   */
+  team: number;
+
+  /**
+   * Sum of the members' scores
+   *
+   * @generated from field: int32 score = 2;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 players = 3;
+   */
+  players: number;
+
+  /**
+   * Members currently frozen or eliminated
+   *
+   * @generated from field: int32 disabled = 4;
+   */
+  disabled: number;
+
+  /**
+   * Enemy flags captured, capture the flag only
+   *
+   * @generated from field: int32 captures = 5;
+   */
+  captures: number;
+
+  /**
+   * Time the team has held the control zone, king of the hill only
+   *
+   * @generated from field: int64 hill_ms = 6;
+   */
+  hillMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.TeamScore.
+ * Use `create(TeamScoreSchema)` to create a new message.
+ */
+export declare const TeamScoreSchema: GenMessage<TeamScore>;
+
+/**
+ * Per-player score for the current round
+ *
+ * @generated from message multiplayer.v1.PlayerScore
+ */
+export declare type PlayerScore = Message<"multiplayer.v1.PlayerScore"> & {
+  /**
+   * @generated from field: multiplayer.v1.ID player_id = 1;
+   */
+  playerId?: ID;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 score = 3;
+   */
+  score: number;
+
+  /**
+   * Opponents frozen by this player's potions
+   *
+   * @generated from field: int32 freezes = 4;
+   */
+  freezes: number;
+
+  /**
+   * @generated from field: int32 aloe_collected = 5;
+   */
+  aloeCollected: number;
+
+  /**
+   * Opponents taken to zero health by this player
+   *
+   * @generated from field: int32 eliminations = 6;
+   */
+  eliminations: number;
+
+  /**
+   * Frozen teammates thawed by this player
+   *
+   * @generated from field: int32 thaws = 7;
+   */
+  thaws: number;
+
+  /**
+   * Enemy flags brought home by this player
+   *
+   * @generated from field: int32 captures = 8;
+   */
+  captures: number;
+
+  /**
+   * Time this player has held the control zone
+   *
+   * @generated from field: int64 hill_ms = 9;
+   */
+  hillMs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.PlayerScore.
+ * Use `create(PlayerScoreSchema)` to create a new message.
+ */
+export declare const PlayerScoreSchema: GenMessage<PlayerScore>;
+
+/**
+ * Sent once when a round ends
+ *
+ * @generated from message multiplayer.v1.MatchResults
+ */
+export declare type MatchResults = Message<"multiplayer.v1.MatchResults"> & {
+  /**
+   * @generated from field: int32 round = 1;
+   */
+  round: number;
+
+  /**
+   * Sorted by score, highest first
+   *
+   * @generated from field: repeated multiplayer.v1.PlayerScore standings = 2;
+   */
+  standings: PlayerScore[];
+
+  /**
+   * @generated from field: multiplayer.v1.ID winner_id = 3;
+   */
+  winnerId?: ID;
+
+  /**
+   * @generated from field: multiplayer.v1.MatchEndReason reason = 4;
+   */
+  reason: MatchEndReason;
+
+  /**
+   * 0 outside team modes or when no team won
+   *
+   * @generated from field: int32 winning_team = 5;
+   */
+  winningTeam: number;
+};
+
+/**
+ * Describes the message multiplayer.v1.MatchResults.
+ * Use `create(MatchResultsSchema)` to create a new message.
+ */
+export declare const MatchResultsSchema: GenMessage<MatchResults>;
+
+/**
+ * Lobby state showing connected users and in-game players
+ *
+ * @generated from message multiplayer.v1.LobbyState
+ */
+export declare type LobbyState = Message<"multiplayer.v1.LobbyState"> & {
+  /**
+   * All connected users (in lobby, not yet in game)
+   *
+   * @generated from field: repeated multiplayer.v1.LobbyUser lobby_users = 1;
+   */
+  lobbyUsers: LobbyUser[];
+
+  /**
+   * Users who have joined the game (readied up)
+   *
+   * @generated from field: repeated multiplayer.v1.LobbyUser game_users = 2;
+   */
+  gameUsers: LobbyUser[];
+
+  /**
+   * Connected spectators, never part of the game
+   *
+   * @generated from field: repeated multiplayer.v1.LobbyUser spectators = 3;
+   */
+  spectators: LobbyUser[];
+};
+
+/**
+ * Describes the message multiplayer.v1.LobbyState.
+ * Use `create(LobbyStateSchema)` to create a new message.
+ */
+export declare const LobbyStateSchema: GenMessage<LobbyState>;
+
+/**
+ * User info for lobby display
+ *
+ * @generated from message multiplayer.v1.LobbyUser
+ */
+export declare type LobbyUser = Message<"multiplayer.v1.LobbyUser"> & {
+  /**
+   * @generated from field: multiplayer.v1.ID user_id = 1;
+   */
+  userId?: ID;
+
+  /**
+   * @generated from field: string name = 2;
    */
   name: string;
 
@@ -375,6 +1331,13 @@ export declare type LobbyUser = Message<"multiplayer.v1.LobbyUser"> & {
    * @generated from field: bool is_ready = 3;
    */
   isReady: boolean;
+
+  /**
+   * Assigned when the user joins the game, 0 in free-for-all
+   *
+   * @generated from field: int32 team = 4;
+   */
+  team: number;
 };
 
 /**
@@ -418,6 +1381,33 @@ export enum GameMessageType {
    * @generated from enum value: GAME_MESSAGE_TYPE_LOBBY_STATE = 5;
    */
   LOBBY_STATE = 5,
+
+  /**
+   * @generated from enum value: GAME_MESSAGE_TYPE_MATCH_RESULTS = 6;
+   */
+  MATCH_RESULTS = 6,
+
+  /**
+   * @generated from enum value: GAME_MESSAGE_TYPE_SNAPSHOT_ACK = 7;
+   */
+  SNAPSHOT_ACK = 7,
+
+  /**
+   * @generated from enum value: GAME_MESSAGE_TYPE_MINIMAP_STATE = 8;
+   */
+  MINIMAP_STATE = 8,
+
+  /**
+   * @generated from enum value: GAME_MESSAGE_TYPE_TIME_SYNC = 9;
+   */
+  TIME_SYNC = 9,
+
+  /**
+   * Spectator choosing whose view to follow
+   *
+   * @generated from enum value: GAME_MESSAGE_TYPE_SPECTATE = 10;
+   */
+  SPECTATE = 10,
 }
 
 /**
@@ -425,6 +1415,118 @@ export enum GameMessageType {
  */
 export declare const GameMessageTypeSchema: GenEnum<GameMessageType>;
 
+/**
+ * @generated from enum multiplayer.v1.StatusEffectType
+ */
+export enum StatusEffectType {
+  /**
+   * @generated from enum value: STATUS_EFFECT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATUS_EFFECT_TYPE_FROZEN = 1;
+   */
+  FROZEN = 1,
+
+  /**
+   * Granted when a freeze ends
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_FREEZE_IMMUNITY = 2;
+   */
+  FREEZE_IMMUNITY = 2,
+
+  /**
+   * Granted when a freeze ends
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_SPEED_BOOST = 3;
+   */
+  SPEED_BOOST = 3,
+
+  /**
+   * Cannot be damaged or frozen after spawning
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_SPAWN_PROTECTION = 4;
+   */
+  SPAWN_PROTECTION = 4,
+
+  /**
+   * Bought with aloe, blocks damage and freezes
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_SHIELD = 5;
+   */
+  SHIELD = 5,
+
+  /**
+   * Hidden from other players' snapshots and the minimap
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_INVISIBLE = 6;
+   */
+  INVISIBLE = 6,
+
+  /**
+   * Holding the enemy flag in capture the flag, slows movement
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_CARRYING_FLAG = 7;
+   */
+  CARRYING_FLAG = 7,
+
+  /**
+   * Outside the battle royale safe zone, each stack slows further
+   *
+   * @generated from enum value: STATUS_EFFECT_TYPE_OUTSIDE_ZONE = 8;
+   */
+  OUTSIDE_ZONE = 8,
+}
+
+/**
+ * Describes the enum multiplayer.v1.StatusEffectType.
+ */
+export declare const StatusEffectTypeSchema: GenEnum<StatusEffectType>;
+
+/**
+ * @generated from enum multiplayer.v1.WorldEventKind
+ */
+export enum WorldEventKind {
+  /**
+   * @generated from enum value: WORLD_EVENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Patches of tiles slow movement
+   *
+   * @generated from enum value: WORLD_EVENT_KIND_QUICKSAND = 1;
+   */
+  QUICKSAND = 1,
+
+  /**
+   * Everyone's view radius shrinks
+   *
+   * @generated from enum value: WORLD_EVENT_KIND_SANDSTORM = 2;
+   */
+  SANDSTORM = 2,
+
+  /**
+   * Meteors land after a warning and leave freeze zones
+   *
+   * @generated from enum value: WORLD_EVENT_KIND_METEOR_SHOWER = 3;
+   */
+  METEOR_SHOWER = 3,
+
+  /**
+   * A cluster of aloe grows in one spot
+   *
+   * @generated from enum value: WORLD_EVENT_KIND_ALOE_BLOOM = 4;
+   */
+  ALOE_BLOOM = 4,
+}
+
+/**
+ * Describes the enum multiplayer.v1.WorldEventKind.
+ */
+export declare const WorldEventKindSchema: GenEnum<WorldEventKind>;
+
 /**
  * Types of projectiles
  *
@@ -465,6 +1567,34 @@ export enum ItemType {
    * @generated from enum value: ITEM_TYPE_ALOE = 1;
    */
   ALOE = 1,
+
+  /**
+   * Grants a shield status effect
+   *
+   * @generated from enum value: ITEM_TYPE_SHIELD = 2;
+   */
+  SHIELD = 2,
+
+  /**
+   * Resets every ability cooldown
+   *
+   * @generated from enum value: ITEM_TYPE_POTION_REFILL = 3;
+   */
+  POTION_REFILL = 3,
+
+  /**
+   * Grants a speed boost
+   *
+   * @generated from enum value: ITEM_TYPE_SPEED_SCROLL = 4;
+   */
+  SPEED_SCROLL = 4,
+
+  /**
+   * Grants invisibility
+   *
+   * @generated from enum value: ITEM_TYPE_INVISIBILITY = 5;
+   */
+  INVISIBILITY = 5,
 }
 
 /**
@@ -472,3 +1602,187 @@ export enum ItemType {
  */
 export declare const ItemTypeSchema: GenEnum<ItemType>;
 
+/**
+ * Current phase of the match lifecycle
+ *
+ * @generated from enum multiplayer.v1.MatchPhase
+ */
+export enum MatchPhase {
+  /**
+   * @generated from enum value: MATCH_PHASE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for enough players to start
+   *
+   * @generated from enum value: MATCH_PHASE_WAITING = 1;
+   */
+  WAITING = 1,
+
+  /**
+   * Match is about to start
+   *
+   * @generated from enum value: MATCH_PHASE_COUNTDOWN = 2;
+   */
+  COUNTDOWN = 2,
+
+  /**
+   * Round is live and scoring
+   *
+   * @generated from enum value: MATCH_PHASE_IN_PROGRESS = 3;
+   */
+  IN_PROGRESS = 3,
+
+  /**
+   * Round is over, showing results before reset
+   *
+   * @generated from enum value: MATCH_PHASE_RESULTS = 4;
+   */
+  RESULTS = 4,
+}
+
+/**
+ * Describes the enum multiplayer.v1.MatchPhase.
+ */
+export declare const MatchPhaseSchema: GenEnum<MatchPhase>;
+
+/**
+ * Why a round ended
+ *
+ * @generated from enum multiplayer.v1.MatchEndReason
+ */
+export enum MatchEndReason {
+  /**
+   * @generated from enum value: MATCH_END_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MATCH_END_REASON_TIME_LIMIT = 1;
+   */
+  TIME_LIMIT = 1,
+
+  /**
+   * @generated from enum value: MATCH_END_REASON_SCORE_LIMIT = 2;
+   */
+  SCORE_LIMIT = 2,
+
+  /**
+   * The game mode's win condition was met
+   *
+   * @generated from enum value: MATCH_END_REASON_OBJECTIVE = 3;
+   */
+  OBJECTIVE = 3,
+}
+
+/**
+ * Describes the enum multiplayer.v1.MatchEndReason.
+ */
+export declare const MatchEndReasonSchema: GenEnum<MatchEndReason>;
+
+/**
+ * @generated from enum multiplayer.v1.GameMode
+ */
+export enum GameMode {
+  /**
+   * @generated from enum value: GAME_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GAME_MODE_FREE_FOR_ALL = 1;
+   */
+  FREE_FOR_ALL = 1,
+
+  /**
+   * Teams win by freezing every opponent at once
+   *
+   * @generated from enum value: GAME_MODE_FREEZE_TAG = 2;
+   */
+  FREEZE_TAG = 2,
+
+  /**
+   * Teams score by bringing the enemy flag to their base
+   *
+   * @generated from enum value: GAME_MODE_CAPTURE_THE_FLAG = 3;
+   */
+  CAPTURE_THE_FLAG = 3,
+
+  /**
+   * Score by holding a control zone that moves periodically
+   *
+   * @generated from enum value: GAME_MODE_KING_OF_THE_HILL = 4;
+   */
+  KING_OF_THE_HILL = 4,
+
+  /**
+   * A shrinking safe zone, last player standing wins
+   *
+   * @generated from enum value: GAME_MODE_BATTLE_ROYALE = 5;
+   */
+  BATTLE_ROYALE = 5,
+}
+
+/**
+ * Describes the enum multiplayer.v1.GameMode.
+ */
+export declare const GameModeSchema: GenEnum<GameMode>;
+
+/**
+ * @generated from enum multiplayer.v1.ZoneShape
+ */
+export enum ZoneShape {
+  /**
+   * @generated from enum value: ZONE_SHAPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ZONE_SHAPE_CIRCLE = 1;
+   */
+  CIRCLE = 1,
+
+  /**
+   * @generated from enum value: ZONE_SHAPE_RECTANGLE = 2;
+   */
+  RECTANGLE = 2,
+}
+
+/**
+ * Describes the enum multiplayer.v1.ZoneShape.
+ */
+export declare const ZoneShapeSchema: GenEnum<ZoneShape>;
+
+/**
+ * @generated from enum multiplayer.v1.FlagStatus
+ */
+export enum FlagStatus {
+  /**
+   * @generated from enum value: FLAG_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FLAG_STATUS_AT_BASE = 1;
+   */
+  AT_BASE = 1,
+
+  /**
+   * @generated from enum value: FLAG_STATUS_CARRIED = 2;
+   */
+  CARRIED = 2,
+
+  /**
+   * Returns to base at returns_at_ms unless picked up first
+   *
+   * @generated from enum value: FLAG_STATUS_DROPPED = 3;
+   */
+  DROPPED = 3,
+}
+
+/**
+ * Describes the enum multiplayer.v1.FlagStatus.
+ */
+export declare const FlagStatusSchema: GenEnum<FlagStatus>;
+
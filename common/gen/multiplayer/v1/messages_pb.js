@@ -10,7 +10,7 @@ import { file_multiplayer_v1_player } from "./player_pb";
  * Describes the file multiplayer/v1/messages.proto.
  */
 export const file_multiplayer_v1_messages = /*@__PURE__*/
-  fileDesc("Ch1tdWx0aXBsYXllci92MS9tZXNzYWdlcy5wcm90bxIObXVsdGlwbGF5ZXIudjEi0AIKC0dhbWVNZXNzYWdlEi0KBHR5cGUYASABKA4yHy5tdWx0aXBsYXllci52MS5HYW1lTWVzc2FnZVR5cGUSMwoMY2hhdF9tZXNzYWdlGAIgASgLMhsubXVsdGlwbGF5ZXIudjEuQ2hhdE1lc3NhZ2VIABIzCgxwbGF5ZXJfZXZlbnQYAyABKAsyGy5tdWx0aXBsYXllci52MS5QbGF5ZXJFdmVudEgAEi8KCmdhbWVfc3RhdGUYBCABKAsyGS5tdWx0aXBsYXllci52MS5HYW1lU3RhdGVIABI5ChFjaGF0X2Fubm91bmNlbWVudBgFIAEoCzIcLm11bHRpcGxheWVyLnYxLkFubm91bmNlbWVudEgAEjEKC2xvYmJ5X3N0YXRlGAYgASgLMhoubXVsdGlwbGF5ZXIudjEuTG9iYnlTdGF0ZUgAQgkKB3BheWxvYWQibQoLQ2hhdE1lc3NhZ2USJQoJc2VuZGVyX2lkGAEgASgLMhIubXVsdGlwbGF5ZXIudjEuSUQSEwoLc2VuZGVyX25hbWUYAiABKAkSDAoEdGV4dBgDIAEoCRIUCgxzZW50X2F0X3VuaXgYBCABKAMiMgoMQW5ub3VuY2VtZW50EgwKBHRleHQYASABKAkSFAoMc2VudF9hdF91bml4GAIgASgDItIBCglHYW1lU3RhdGUSLAoHcGxheWVycxgBIAMoCzIbLm11bHRpcGxheWVyLnYxLlBsYXllclN0YXRlEjQKC3Byb2plY3RpbGVzGAIgAygLMh8ubXVsdGlwbGF5ZXIudjEuUHJvamVjdGlsZVN0YXRlEigKBWl0ZW1zGAMgAygLMhkubXVsdGlwbGF5ZXIudjEuSXRlbVN0YXRlEjcKD3F1aWNrc2FuZF9ldmVudBgEIAEoCzIeLm11bHRpcGxheWVyLnYxLlF1aWNrc2FuZEV2ZW50IrcBCgtQbGF5ZXJTdGF0ZRIlCglwbGF5ZXJfaWQYASABKAsyEi5tdWx0aXBsYXllci52MS5JRBIpCghwb3NpdGlvbhgCIAEoCzIXLm11bHRpcGxheWVyLnYxLlZlY3RvcjISEQoJaXNfZnJvemVuGAMgASgIEhQKDGZyb3plbl91bnRpbBgEIAEoAhISCgphbG9lX2NvdW50GAUgASgFEhkKEXNwZWVkX2Jvb3N0X3VudGlsGAYgASgCIuABCg9Qcm9qZWN0aWxlU3RhdGUSFQoNcHJvamVjdGlsZV9pZBgBIAEoCRIsCgR0eXBlGAIgASgOMh4ubXVsdGlwbGF5ZXIudjEuUHJvamVjdGlsZVR5cGUSKQoIcG9zaXRpb24YAyABKAsyFy5tdWx0aXBsYXllci52MS5WZWN0b3IyEicKBnRhcmdldBgEIAEoCzIXLm11bHRpcGxheWVyLnYxLlZlY3RvcjISJAoIb3duZXJfaWQYBSABKAsyEi5tdWx0aXBsYXllci52MS5JRBIOCgZhY3RpdmUYBiABKAgifwoJSXRlbVN0YXRlEg8KB2l0ZW1faWQYASABKAkSJgoEdHlwZRgCIAEoDjIYLm11bHRpcGxheWVyLnYxLkl0ZW1UeXBlEikKCHBvc2l0aW9uGAMgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIOCgZhY3RpdmUYBCABKAgiIQoJVGlsZUNvb3JkEgkKAXgYASABKAUSCQoBeRgCIAEoBSJfCg5RdWlja3NhbmRFdmVudBIoCgV0aWxlcxgBIAMoCzIZLm11bHRpcGxheWVyLnYxLlRpbGVDb29yZBISCgpleHBpcmVzX2F0GAIgASgCEg8KB3RpbGVfaWQYAyABKAUiawoKTG9iYnlTdGF0ZRIuCgtsb2JieV91c2VycxgBIAMoCzIZLm11bHRpcGxheWVyLnYxLkxvYmJ5VXNlchItCgpnYW1lX3VzZXJzGAIgAygLMhkubXVsdGlwbGF5ZXIudjEuTG9iYnlVc2VyIlAKCUxvYmJ5VXNlchIjCgd1c2VyX2lkGAEgASgLMhIubXVsdGlwbGF5ZXIudjEuSUQSDAoEbmFtZRgCIAEoCRIQCghpc19yZWFkeRgDIAEoCCrlAQoPR2FtZU1lc3NhZ2VUeXBlEiEKHUdBTUVfTUVTU0FHRV9UWVBFX1VOU1BFQ0lGSUVEEAASIgoeR0FNRV9NRVNTQUdFX1RZUEVfQ0hBVF9NRVNTQUdFEAESIgoeR0FNRV9NRVNTQUdFX1RZUEVfUExBWUVSX0VWRU5UEAISIAocR0FNRV9NRVNTQUdFX1RZUEVfR0FNRV9TVEFURRADEiIKHkdBTUVfTUVTU0FHRV9UWVBFX0FOTk9VTkNFTUVOVBAEEiEKHUdBTUVfTUVTU0FHRV9UWVBFX0xPQkJZX1NUQVRFEAUqcgoOUHJvamVjdGlsZVR5cGUSHwobUFJPSkVDVElMRV9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYUFJPSkVDVElMRV9UWVBFX0ZJUkVCQUxMEAESIQodUFJPSkVDVElMRV9UWVBFX0ZSRUVaRV9QT1RJT04QAio5CghJdGVtVHlwZRIZChVJVEVNX1RZUEVfVU5TUEVDSUZJRUQQABISCg5JVEVNX1RZUEVfQUxPRRABQsgBChJjb20ubXVsdGlwbGF5ZXIudjFCDU1lc3NhZ2VzUHJvdG9QAVpKZ2l0aHViLmNvbS9zb25hc3RlYS9XaXphcmRXYXJyaW9ycy9jb21tb24vZ2VuL211bHRpcGxheWVyL3YxO211bHRpcGxheWVydjGiAgNNWFiqAg5NdWx0aXBsYXllci5WMcoCDk11bHRpcGxheWVyXFYx4gIaTXVsdGlwbGF5ZXJcVjFcR1BCTWV0YWRhdGHqAg9NdWx0aXBsYXllcjo6VjFiBnByb3RvMw", [file_multiplayer_v1_common, file_multiplayer_v1_player]);
+  fileDesc("Ch1tdWx0aXBsYXllci92MS9tZXNzYWdlcy5wcm90bxIObXVsdGlwbGF5ZXIudjEi3wQKC0dhbWVNZXNzYWdlEi0KBHR5cGUYASABKA4yHy5tdWx0aXBsYXllci52MS5HYW1lTWVzc2FnZVR5cGUSMwoMY2hhdF9tZXNzYWdlGAIgASgLMhsubXVsdGlwbGF5ZXIudjEuQ2hhdE1lc3NhZ2VIABIzCgxwbGF5ZXJfZXZlbnQYAyABKAsyGy5tdWx0aXBsYXllci52MS5QbGF5ZXJFdmVudEgAEi8KCmdhbWVfc3RhdGUYBCABKAsyGS5tdWx0aXBsYXllci52MS5HYW1lU3RhdGVIABI5ChFjaGF0X2Fubm91bmNlbWVudBgFIAEoCzIcLm11bHRpcGxheWVyLnYxLkFubm91bmNlbWVudEgAEjEKC2xvYmJ5X3N0YXRlGAYgASgLMhoubXVsdGlwbGF5ZXIudjEuTG9iYnlTdGF0ZUgAEjUKDW1hdGNoX3Jlc3VsdHMYByABKAsyHC5tdWx0aXBsYXllci52MS5NYXRjaFJlc3VsdHNIABIzCgxzbmFwc2hvdF9hY2sYCCABKAsyGy5tdWx0aXBsYXllci52MS5TbmFwc2hvdEFja0gAEjUKDW1pbmltYXBfc3RhdGUYCSABKAsyHC5tdWx0aXBsYXllci52MS5NaW5pbWFwU3RhdGVIABItCgl0aW1lX3N5bmMYCiABKAsyGC5tdWx0aXBsYXllci52MS5UaW1lU3luY0gAEjsKEHNwZWN0YXRvcl9mb2xsb3cYCyABKAsyHy5tdWx0aXBsYXllci52MS5TcGVjdGF0b3JGb2xsb3dIAEIJCgdwYXlsb2FkInQKCEVudmVsb3BlEiUKCXNlbmRlcl9pZBgBIAEoCzISLm11bHRpcGxheWVyLnYxLklEEhMKC3NlbmRlcl9uYW1lGAIgASgJEiwKB21lc3NhZ2UYAyABKAsyGy5tdWx0aXBsYXllci52MS5HYW1lTWVzc2FnZSJtCgtDaGF0TWVzc2FnZRIlCglzZW5kZXJfaWQYASABKAsyEi5tdWx0aXBsYXllci52MS5JRBITCgtzZW5kZXJfbmFtZRgCIAEoCRIMCgR0ZXh0GAMgASgJEhQKDHNlbnRfYXRfdW5peBgEIAEoAyIyCgxBbm5vdW5jZW1lbnQSDAoEdGV4dBgBIAEoCRIUCgxzZW50X2F0X3VuaXgYAiABKAMimQQKCUdhbWVTdGF0ZRIsCgdwbGF5ZXJzGAEgAygLMhsubXVsdGlwbGF5ZXIudjEuUGxheWVyU3RhdGUSNAoLcHJvamVjdGlsZXMYAiADKAsyHy5tdWx0aXBsYXllci52MS5Qcm9qZWN0aWxlU3RhdGUSKAoFaXRlbXMYAyADKAsyGS5tdWx0aXBsYXllci52MS5JdGVtU3RhdGUSNwoPcXVpY2tzYW5kX2V2ZW50GAQgASgLMh4ubXVsdGlwbGF5ZXIudjEuUXVpY2tzYW5kRXZlbnQSKQoFbWF0Y2gYBSABKAsyGi5tdWx0aXBsYXllci52MS5NYXRjaFN0YXRlEhMKC3NuYXBzaG90X2lkGAYgASgDEhMKC2Jhc2VsaW5lX2lkGAcgASgDEisKD3JlbW92ZWRfcGxheWVycxgIIAMoCzISLm11bHRpcGxheWVyLnYxLklEEhsKE3JlbW92ZWRfcHJvamVjdGlsZXMYCSADKAkSFQoNcmVtb3ZlZF9pdGVtcxgKIAMoCRIZChFxdWlja3NhbmRfY2xlYXJlZBgLIAEoCBIMCgR0aWNrGAwgASgDEhYKDnNlcnZlcl90aW1lX21zGA0gASgDEjAKDHdvcmxkX2V2ZW50cxgOIAMoCzIaLm11bHRpcGxheWVyLnYxLldvcmxkRXZlbnQSHAoUcmVtb3ZlZF93b3JsZF9ldmVudHMYDyADKAkiVQoIVGltZVN5bmMSFgoOY2xpZW50X3NlbmRfbXMYASABKAMSGQoRc2VydmVyX3JlY2VpdmVfbXMYAiABKAMSFgoOc2VydmVyX3NlbmRfbXMYAyABKAMiIgoLU25hcHNob3RBY2sSEwoLc25hcHNob3RfaWQYASABKAMiOAoPU3BlY3RhdG9yRm9sbG93EiUKCXBsYXllcl9pZBgBIAEoCzISLm11bHRpcGxheWVyLnYxLklEIoUECgtQbGF5ZXJTdGF0ZRIlCglwbGF5ZXJfaWQYASABKAsyEi5tdWx0aXBsYXllci52MS5JRBIpCghwb3NpdGlvbhgCIAEoCzIXLm11bHRpcGxheWVyLnYxLlZlY3RvcjISEQoJaXNfZnJvemVuGAMgASgIEhQKDGZyb3plbl91bnRpbBgEIAEoAhISCgphbG9lX2NvdW50GAUgASgFEhkKEXNwZWVkX2Jvb3N0X3VudGlsGAYgASgCEhwKFGxhc3RfcHJvY2Vzc2VkX2lucHV0GAcgASgNEhcKD2Zyb3plbl91bnRpbF9tcxgIIAEoAxIcChRzcGVlZF9ib29zdF91bnRpbF9tcxgJIAEoAxIOCgZoZWFsdGgYCiABKAUSEgoKbWF4X2hlYWx0aBgLIAEoBRIVCg1pc19lbGltaW5hdGVkGAwgASgIEhUKDXJlc3Bhd25fYXRfbXMYDSABKAMSHQoVaW52dWxuZXJhYmxlX3VudGlsX21zGA4gASgDEjIKCWNvb2xkb3ducxgPIAMoCzIfLm11bHRpcGxheWVyLnYxLkFiaWxpdHlDb29sZG93bhItCgdlZmZlY3RzGBAgAygLMhwubXVsdGlwbGF5ZXIudjEuU3RhdHVzRWZmZWN0EgwKBHRlYW0YESABKAUSFQoNdGhhd19wcm9ncmVzcxgSIAEoAiKXAQoMU3RhdHVzRWZmZWN0Ei4KBHR5cGUYASABKA4yIC5tdWx0aXBsYXllci52MS5TdGF0dXNFZmZlY3RUeXBlEhUKDWV4cGlyZXNfYXRfbXMYAiABKAMSDgoGc3RhY2tzGAMgASgFEhgKEHNwZWVkX211bHRpcGxpZXIYBCABKAISFgoOYmxvY2tzX2FjdGlvbnMYBSABKAgiUwoPQWJpbGl0eUNvb2xkb3duEioKBmFjdGlvbhgBIAEoDjIaLm11bHRpcGxheWVyLnYxLkFjdGlvblR5cGUSFAoMcmVtYWluaW5nX21zGAIgASgDIuABCg9Qcm9qZWN0aWxlU3RhdGUSFQoNcHJvamVjdGlsZV9pZBgBIAEoCRIsCgR0eXBlGAIgASgOMh4ubXVsdGlwbGF5ZXIudjEuUHJvamVjdGlsZVR5cGUSKQoIcG9zaXRpb24YAyABKAsyFy5tdWx0aXBsYXllci52MS5WZWN0b3IyEicKBnRhcmdldBgEIAEoCzIXLm11bHRpcGxheWVyLnYxLlZlY3RvcjISJAoIb3duZXJfaWQYBSABKAsyEi5tdWx0aXBsYXllci52MS5JRBIOCgZhY3RpdmUYBiABKAgifwoJSXRlbVN0YXRlEg8KB2l0ZW1faWQYASABKAkSJgoEdHlwZRgCIAEoDjIYLm11bHRpcGxheWVyLnYxLkl0ZW1UeXBlEikKCHBvc2l0aW9uGAMgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIOCgZhY3RpdmUYBCABKAgiPgoMTWluaW1hcFN0YXRlEi4KB21hcmtlcnMYASADKAsyHS5tdWx0aXBsYXllci52MS5NaW5pbWFwTWFya2VyIl8KDU1pbmltYXBNYXJrZXISJQoJcGxheWVyX2lkGAEgASgLMhIubXVsdGlwbGF5ZXIudjEuSUQSJwoEdGlsZRgCIAEoCzIZLm11bHRpcGxheWVyLnYxLlRpbGVDb29yZCIhCglUaWxlQ29vcmQSCQoBeBgBIAEoBRIJCgF5GAIgASgFInYKDlF1aWNrc2FuZEV2ZW50EigKBXRpbGVzGAEgAygLMhkubXVsdGlwbGF5ZXIudjEuVGlsZUNvb3JkEhIKCmV4cGlyZXNfYXQYAiABKAISDwoHdGlsZV9pZBgDIAEoBRIVCg1leHBpcmVzX2F0X21zGAQgASgDIm4KBk1ldGVvchIpCghwb3NpdGlvbhgBIAEoCzIXLm11bHRpcGxheWVyLnYxLlZlY3RvcjISDgoGcmFkaXVzGAIgASgCEhQKDGltcGFjdF9hdF9tcxgDIAEoAxITCgtmYWRlc19hdF9tcxgEIAEoAyKqAgoKV29ybGRFdmVudBIQCghldmVudF9pZBgBIAEoCRIsCgRraW5kGAIgASgOMh4ubXVsdGlwbGF5ZXIudjEuV29ybGRFdmVudEtpbmQSFQoNc3RhcnRlZF9hdF9tcxgDIAEoAxISCgplbmRzX2F0X21zGAQgASgDEigKBXRpbGVzGAUgAygLMhkubXVsdGlwbGF5ZXIudjEuVGlsZUNvb3JkEg8KB3RpbGVfaWQYBiABKAUSFAoMdmlzaW9uX3NjYWxlGAcgASgCEicKB21ldGVvcnMYCCADKAsyFi5tdWx0aXBsYXllci52MS5NZXRlb3ISJwoGY2VudGVyGAkgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIOCgZyYWRpdXMYCiABKAIi8gIKCk1hdGNoU3RhdGUSKQoFcGhhc2UYASABKA4yGi5tdWx0aXBsYXllci52MS5NYXRjaFBoYXNlEg0KBXJvdW5kGAIgASgFEhQKDHJlbWFpbmluZ19tcxgDIAEoAxIrCgZzY29yZXMYBCADKAsyGy5tdWx0aXBsYXllci52MS5QbGF5ZXJTY29yZRITCgtzY29yZV9saW1pdBgFIAEoBRImCgRtb2RlGAYgASgOMhgubXVsdGlwbGF5ZXIudjEuR2FtZU1vZGUSKAoFdGVhbXMYByADKAsyGS5tdWx0aXBsYXllci52MS5UZWFtU2NvcmUSKAoFZmxhZ3MYCCADKAsyGS5tdWx0aXBsYXllci52MS5GbGFnU3RhdGUSKQoEem9uZRgJIAEoCzIbLm11bHRpcGxheWVyLnYxLkNvbnRyb2xab25lEisKCXNhZmVfem9uZRgKIAEoCzIYLm11bHRpcGxheWVyLnYxLlNhZmVab25lIpICCgtDb250cm9sWm9uZRIoCgVzaGFwZRgBIAEoDjIZLm11bHRpcGxheWVyLnYxLlpvbmVTaGFwZRInCgZjZW50ZXIYAiABKAsyFy5tdWx0aXBsYXllci52MS5WZWN0b3IyEg4KBnJhZGl1cxgDIAEoAhINCgV3aWR0aBgEIAEoAhIOCgZoZWlnaHQYBSABKAISEwoLbW92ZXNfYXRfbXMYBiABKAMSEQoJY29udGVzdGVkGAcgASgIEikKDWNvbnRyb2xsZXJfaWQYCCABKAsyEi5tdWx0aXBsYXllci52MS5JRBIXCg9jb250cm9sbGVyX3RlYW0YCSABKAUSFQoNaG9sZF9saW1pdF9tcxgKIAEoAyLXAQoIU2FmZVpvbmUSJwoGY2VudGVyGAEgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIOCgZyYWRpdXMYAiABKAISLAoLbmV4dF9jZW50ZXIYAyABKAsyFy5tdWx0aXBsYXllci52MS5WZWN0b3IyEhMKC25leHRfcmFkaXVzGAQgASgCEg0KBXN0YWdlGAUgASgFEg4KBnN0YWdlcxgGIAEoBRIVCg1zaHJpbmtzX2F0X21zGAcgASgDEhkKEXNocmlua19lbmRzX2F0X21zGAggASgDItYBCglGbGFnU3RhdGUSDAoEdGVhbRgBIAEoBRIqCgZzdGF0dXMYAiABKA4yGi5tdWx0aXBsYXllci52MS5GbGFnU3RhdHVzEikKCHBvc2l0aW9uGAMgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIlCgRiYXNlGAQgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhImCgpjYXJyaWVyX2lkGAUgASgLMhIubXVsdGlwbGF5ZXIudjEuSUQSFQoNcmV0dXJuc19hdF9tcxgGIAEoAyJuCglUZWFtU2NvcmUSDAoEdGVhbRgBIAEoBRINCgVzY29yZRgCIAEoBRIPCgdwbGF5ZXJzGAMgASgFEhAKCGRpc2FibGVkGAQgASgFEhAKCGNhcHR1cmVzGAUgASgFEg8KB2hpbGxfbXMYBiABKAMiwgEKC1BsYXllclNjb3JlEiUKCXBsYXllcl9pZBgBIAEoCzISLm11bHRpcGxheWVyLnYxLklEEgwKBG5hbWUYAiABKAkSDQoFc2NvcmUYAyABKAUSDwoHZnJlZXplcxgEIAEoBRIWCg5hbG9lX2NvbGxlY3RlZBgFIAEoBRIUCgxlbGltaW5hdGlvbnMYBiABKAUSDQoFdGhhd3MYByABKAUSEAoIY2FwdHVyZXMYCCABKAUSDwoHaGlsbF9tcxgJIAEoAyK6AQoMTWF0Y2hSZXN1bHRzEg0KBXJvdW5kGAEgASgFEi4KCXN0YW5kaW5ncxgCIAMoCzIbLm11bHRpcGxheWVyLnYxLlBsYXllclNjb3JlEiUKCXdpbm5lcl9pZBgDIAEoCzISLm11bHRpcGxheWVyLnYxLklEEi4KBnJlYXNvbhgEIAEoDjIeLm11bHRpcGxheWVyLnYxLk1hdGNoRW5kUmVhc29uEhQKDHdpbm5pbmdfdGVhbRgFIAEoBSKaAQoKTG9iYnlTdGF0ZRIuCgtsb2JieV91c2VycxgBIAMoCzIZLm11bHRpcGxheWVyLnYxLkxvYmJ5VXNlchItCgpnYW1lX3VzZXJzGAIgAygLMhkubXVsdGlwbGF5ZXIudjEuTG9iYnlVc2VyEi0KCnNwZWN0YXRvcnMYAyADKAsyGS5tdWx0aXBsYXllci52MS5Mb2JieVVzZXIiXgoJTG9iYnlVc2VyEiMKB3VzZXJfaWQYASABKAsyEi5tdWx0aXBsYXllci52MS5JRBIMCgRuYW1lGAIgASgJEhAKCGlzX3JlYWR5GAMgASgIEgwKBHRlYW0YBCABKAUqlAMKD0dhbWVNZXNzYWdlVHlwZRIhCh1HQU1FX01FU1NBR0VfVFlQRV9VTlNQRUNJRklFRBAAEiIKHkdBTUVfTUVTU0FHRV9UWVBFX0NIQVRfTUVTU0FHRRABEiIKHkdBTUVfTUVTU0FHRV9UWVBFX1BMQVlFUl9FVkVOVBACEiAKHEdBTUVfTUVTU0FHRV9UWVBFX0dBTUVfU1RBVEUQAxIiCh5HQU1FX01FU1NBR0VfVFlQRV9BTk5PVU5DRU1FTlQQBBIhCh1HQU1FX01FU1NBR0VfVFlQRV9MT0JCWV9TVEFURRAFEiMKH0dBTUVfTUVTU0FHRV9UWVBFX01BVENIX1JFU1VMVFMQBhIiCh5HQU1FX01FU1NBR0VfVFlQRV9TTkFQU0hPVF9BQ0sQBxIjCh9HQU1FX01FU1NBR0VfVFlQRV9NSU5JTUFQX1NUQVRFEAgSHwobR0FNRV9NRVNTQUdFX1RZUEVfVElNRV9TWU5DEAkSHgoaR0FNRV9NRVNTQUdFX1RZUEVfU1BFQ1RBVEUQCirWAgoQU3RhdHVzRWZmZWN0VHlwZRIiCh5TVEFUVVNfRUZGRUNUX1RZUEVfVU5TUEVDSUZJRUQQABIdChlTVEFUVVNfRUZGRUNUX1RZUEVfRlJPWkVOEAESJgoiU1RBVFVTX0VGRkVDVF9UWVBFX0ZSRUVaRV9JTU1VTklUWRACEiIKHlNUQVRVU19FRkZFQ1RfVFlQRV9TUEVFRF9CT09TVBADEicKI1NUQVRVU19FRkZFQ1RfVFlQRV9TUEFXTl9QUk9URUNUSU9OEAQSHQoZU1RBVFVTX0VGRkVDVF9UWVBFX1NISUVMRBAFEiAKHFNUQVRVU19FRkZFQ1RfVFlQRV9JTlZJU0lCTEUQBhIkCiBTVEFUVVNfRUZGRUNUX1RZUEVfQ0FSUllJTkdfRkxBRxAHEiMKH1NUQVRVU19FRkZFQ1RfVFlQRV9PVVRTSURFX1pPTkUQCCq3AQoOV29ybGRFdmVudEtpbmQSIAocV09STERfRVZFTlRfS0lORF9VTlNQRUNJRklFRBAAEh4KGldPUkxEX0VWRU5UX0tJTkRfUVVJQ0tTQU5EEAESHgoaV09STERfRVZFTlRfS0lORF9TQU5EU1RPUk0QAhIiCh5XT1JMRF9FVkVOVF9LSU5EX01FVEVPUl9TSE9XRVIQAxIfChtXT1JMRF9FVkVOVF9LSU5EX0FMT0VfQkxPT00QBCpyCg5Qcm9qZWN0aWxlVHlwZRIfChtQUk9KRUNUSUxFX1RZUEVfVU5TUEVDSUZJRUQQABIcChhQUk9KRUNUSUxFX1RZUEVfRklSRUJBTEwQARIhCh1QUk9KRUNUSUxFX1RZUEVfRlJFRVpFX1BPVElPThACKqQBCghJdGVtVHlwZRIZChVJVEVNX1RZUEVfVU5TUEVDSUZJRUQQABISCg5JVEVNX1RZUEVfQUxPRRABEhQKEElURU1fVFlQRV9TSElFTEQQAhIbChdJVEVNX1RZUEVfUE9USU9OX1JFRklMTBADEhoKFklURU1fVFlQRV9TUEVFRF9TQ1JPTEwQBBIaChZJVEVNX1RZUEVfSU5WSVNJQklMSVRZEAUqkwEKCk1hdGNoUGhhc2USGwoXTUFUQ0hfUEhBU0VfVU5TUEVDSUZJRUQQABIXChNNQVRDSF9QSEFTRV9XQUlUSU5HEAESGQoVTUFUQ0hfUEhBU0VfQ09VTlRET1dOEAISGwoXTUFUQ0hfUEhBU0VfSU5fUFJPR1JFU1MQAxIXChNNQVRDSF9QSEFTRV9SRVNVTFRTEAQqlQEKDk1hdGNoRW5kUmVhc29uEiAKHE1BVENIX0VORF9SRUFTT05fVU5TUEVDSUZJRUQQABIfChtNQVRDSF9FTkRfUkVBU09OX1RJTUVfTElNSVQQARIgChxNQVRDSF9FTkRfUkVBU09OX1NDT1JFX0xJTUlUEAISHgoaTUFUQ0hfRU5EX1JFQVNPTl9PQkpFQ1RJVkUQAyq4AQoIR2FtZU1vZGUSGQoVR0FNRV9NT0RFX1VOU1BFQ0lGSUVEEAASGgoWR0FNRV9NT0RFX0ZSRUVfRk9SX0FMTBABEhgKFEdBTUVfTU9ERV9GUkVFWkVfVEFHEAISHgoaR0FNRV9NT0RFX0NBUFRVUkVfVEhFX0ZMQUcQAxIeChpHQU1FX01PREVfS0lOR19PRl9USEVfSElMTBAEEhsKF0dBTUVfTU9ERV9CQVRUTEVfUk9ZQUxFEAUqWAoJWm9uZVNoYXBlEhoKFlpPTkVfU0hBUEVfVU5TUEVDSUZJRUQQABIVChFaT05FX1NIQVBFX0NJUkNMRRABEhgKFFpPTkVfU0hBUEVfUkVDVEFOR0xFEAIqdAoKRmxhZ1N0YXR1cxIbChdGTEFHX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE0ZMQUdfU1RBVFVTX0FUX0JBU0UQARIXChNGTEFHX1NUQVRVU19DQVJSSUVEEAISFwoTRkxBR19TVEFUVVNfRFJPUFBFRBADQsgBChJjb20ubXVsdGlwbGF5ZXIudjFCDU1lc3NhZ2VzUHJvdG9QAVpKZ2l0aHViLmNvbS9zb25hc3RlYS9XaXphcmRXYXJyaW9ycy9jb21tb24vZ2VuL211bHRpcGxheWVyL3YxO211bHRpcGxheWVydjGiAgNNWFiqAg5NdWx0aXBsYXllci5WMcoCDk11bHRpcGxheWVyXFYx4gIaTXVsdGlwbGF5ZXJcVjFcR1BCTWV0YWRhdGHqAg9NdWx0aXBsYXllcjo6VjFiBnByb3RvMw", [file_multiplayer_v1_common, file_multiplayer_v1_player]);
 
 /**
  * Describes the message multiplayer.v1.GameMessage.
@@ -19,75 +19,194 @@ export const file_multiplayer_v1_messages = /*@__PURE__*/
 export const GameMessageSchema = /*@__PURE__*/
   messageDesc(file_multiplayer_v1_messages, 0);
 
+/**
+ * Describes the message multiplayer.v1.Envelope.
+ * Use `create(EnvelopeSchema)` to create a new message.
+ */
+export const EnvelopeSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 1);
+
 /**
  * Describes the message multiplayer.v1.ChatMessage.
  * Use `create(ChatMessageSchema)` to create a new message.
  */
 export const ChatMessageSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 1);
+  messageDesc(file_multiplayer_v1_messages, 2);
 
 /**
  * Describes the message multiplayer.v1.Announcement.
  * Use `create(AnnouncementSchema)` to create a new message.
  */
 export const AnnouncementSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 2);
+  messageDesc(file_multiplayer_v1_messages, 3);
 
 /**
  * Describes the message multiplayer.v1.GameState.
  * Use `create(GameStateSchema)` to create a new message.
  */
 export const GameStateSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 3);
+  messageDesc(file_multiplayer_v1_messages, 4);
+
+/**
+ * Describes the message multiplayer.v1.TimeSync.
+ * Use `create(TimeSyncSchema)` to create a new message.
+ */
+export const TimeSyncSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 5);
+
+/**
+ * Describes the message multiplayer.v1.SnapshotAck.
+ * Use `create(SnapshotAckSchema)` to create a new message.
+ */
+export const SnapshotAckSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 6);
+
+/**
+ * Describes the message multiplayer.v1.SpectatorFollow.
+ * Use `create(SpectatorFollowSchema)` to create a new message.
+ */
+export const SpectatorFollowSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 7);
 
 /**
  * Describes the message multiplayer.v1.PlayerState.
  * Use `create(PlayerStateSchema)` to create a new message.
  */
 export const PlayerStateSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 4);
+  messageDesc(file_multiplayer_v1_messages, 8);
+
+/**
+ * Describes the message multiplayer.v1.StatusEffect.
+ * Use `create(StatusEffectSchema)` to create a new message.
+ */
+export const StatusEffectSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 9);
+
+/**
+ * Describes the message multiplayer.v1.AbilityCooldown.
+ * Use `create(AbilityCooldownSchema)` to create a new message.
+ */
+export const AbilityCooldownSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 10);
 
 /**
  * Describes the message multiplayer.v1.ProjectileState.
  * Use `create(ProjectileStateSchema)` to create a new message.
  */
 export const ProjectileStateSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 5);
+  messageDesc(file_multiplayer_v1_messages, 11);
 
 /**
  * Describes the message multiplayer.v1.ItemState.
  * Use `create(ItemStateSchema)` to create a new message.
  */
 export const ItemStateSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 6);
+  messageDesc(file_multiplayer_v1_messages, 12);
+
+/**
+ * Describes the message multiplayer.v1.MinimapState.
+ * Use `create(MinimapStateSchema)` to create a new message.
+ */
+export const MinimapStateSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 13);
+
+/**
+ * Describes the message multiplayer.v1.MinimapMarker.
+ * Use `create(MinimapMarkerSchema)` to create a new message.
+ */
+export const MinimapMarkerSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 14);
 
 /**
  * Describes the message multiplayer.v1.TileCoord.
  * Use `create(TileCoordSchema)` to create a new message.
  */
 export const TileCoordSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 7);
+  messageDesc(file_multiplayer_v1_messages, 15);
 
 /**
  * Describes the message multiplayer.v1.QuicksandEvent.
  * Use `create(QuicksandEventSchema)` to create a new message.
  */
 export const QuicksandEventSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 8);
+  messageDesc(file_multiplayer_v1_messages, 16);
+
+/**
+ * Describes the message multiplayer.v1.Meteor.
+ * Use `create(MeteorSchema)` to create a new message.
+ */
+export const MeteorSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 17);
+
+/**
+ * Describes the message multiplayer.v1.WorldEvent.
+ * Use `create(WorldEventSchema)` to create a new message.
+ */
+export const WorldEventSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 18);
+
+/**
+ * Describes the message multiplayer.v1.MatchState.
+ * Use `create(MatchStateSchema)` to create a new message.
+ */
+export const MatchStateSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 19);
+
+/**
+ * Describes the message multiplayer.v1.ControlZone.
+ * Use `create(ControlZoneSchema)` to create a new message.
+ */
+export const ControlZoneSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 20);
+
+/**
+ * Describes the message multiplayer.v1.SafeZone.
+ * Use `create(SafeZoneSchema)` to create a new message.
+ */
+export const SafeZoneSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 21);
+
+/**
+ * Describes the message multiplayer.v1.FlagState.
+ * Use `create(FlagStateSchema)` to create a new message.
+ */
+export const FlagStateSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 22);
+
+/**
+ * Describes the message multiplayer.v1.TeamScore.
+ * Use `create(TeamScoreSchema)` to create a new message.
+ */
+export const TeamScoreSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 23);
+
+/**
+ * Describes the message multiplayer.v1.PlayerScore.
+ * Use `create(PlayerScoreSchema)` to create a new message.
+ */
+export const PlayerScoreSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 24);
+
+/**
+ * Describes the message multiplayer.v1.MatchResults.
+ * Use `create(MatchResultsSchema)` to create a new message.
+ */
+export const MatchResultsSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_messages, 25);
 
 /**
  * Describes the message multiplayer.v1.LobbyState.
  * Use `create(LobbyStateSchema)` to create a new message.
  */
 export const LobbyStateSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 9);
+  messageDesc(file_multiplayer_v1_messages, 26);
 
 /**
  * Describes the message multiplayer.v1.LobbyUser.
  * Use `create(LobbyUserSchema)` to create a new message.
  */
 export const LobbyUserSchema = /*@__PURE__*/
-  messageDesc(file_multiplayer_v1_messages, 10);
+  messageDesc(file_multiplayer_v1_messages, 27);
 
 /**
  * Describes the enum multiplayer.v1.GameMessageType.
@@ -103,11 +222,35 @@ export const GameMessageTypeSchema = /*@__PURE__*/
 export const GameMessageType = /*@__PURE__*/
   tsEnum(GameMessageTypeSchema);
 
+/**
+ * Describes the enum multiplayer.v1.StatusEffectType.
+ */
+export const StatusEffectTypeSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 1);
+
+/**
+ * @generated from enum multiplayer.v1.StatusEffectType
+ */
+export const StatusEffectType = /*@__PURE__*/
+  tsEnum(StatusEffectTypeSchema);
+
+/**
+ * Describes the enum multiplayer.v1.WorldEventKind.
+ */
+export const WorldEventKindSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 2);
+
+/**
+ * @generated from enum multiplayer.v1.WorldEventKind
+ */
+export const WorldEventKind = /*@__PURE__*/
+  tsEnum(WorldEventKindSchema);
+
 /**
  * Describes the enum multiplayer.v1.ProjectileType.
  */
 export const ProjectileTypeSchema = /*@__PURE__*/
-  enumDesc(file_multiplayer_v1_messages, 1);
+  enumDesc(file_multiplayer_v1_messages, 3);
 
 /**
  * Types of projectiles
@@ -121,7 +264,7 @@ export const ProjectileType = /*@__PURE__*/
  * Describes the enum multiplayer.v1.ItemType.
  */
 export const ItemTypeSchema = /*@__PURE__*/
-  enumDesc(file_multiplayer_v1_messages, 2);
+  enumDesc(file_multiplayer_v1_messages, 4);
 
 /**
  * @generated from enum multiplayer.v1.ItemType
@@ -129,3 +272,67 @@ export const ItemTypeSchema = /*@__PURE__*/
 export const ItemType = /*@__PURE__*/
   tsEnum(ItemTypeSchema);
 
+/**
+ * Describes the enum multiplayer.v1.MatchPhase.
+ */
+export const MatchPhaseSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 5);
+
+/**
+ * Current phase of the match lifecycle
+ *
+ * @generated from enum multiplayer.v1.MatchPhase
+ */
+export const MatchPhase = /*@__PURE__*/
+  tsEnum(MatchPhaseSchema);
+
+/**
+ * Describes the enum multiplayer.v1.MatchEndReason.
+ */
+export const MatchEndReasonSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 6);
+
+/**
+ * Why a round ended
+ *
+ * @generated from enum multiplayer.v1.MatchEndReason
+ */
+export const MatchEndReason = /*@__PURE__*/
+  tsEnum(MatchEndReasonSchema);
+
+/**
+ * Describes the enum multiplayer.v1.GameMode.
+ */
+export const GameModeSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 7);
+
+/**
+ * @generated from enum multiplayer.v1.GameMode
+ */
+export const GameMode = /*@__PURE__*/
+  tsEnum(GameModeSchema);
+
+/**
+ * Describes the enum multiplayer.v1.ZoneShape.
+ */
+export const ZoneShapeSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 8);
+
+/**
+ * @generated from enum multiplayer.v1.ZoneShape
+ */
+export const ZoneShape = /*@__PURE__*/
+  tsEnum(ZoneShapeSchema);
+
+/**
+ * Describes the enum multiplayer.v1.FlagStatus.
+ */
+export const FlagStatusSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_messages, 9);
+
+/**
+ * @generated from enum multiplayer.v1.FlagStatus
+ */
+export const FlagStatus = /*@__PURE__*/
+  tsEnum(FlagStatusSchema);
+
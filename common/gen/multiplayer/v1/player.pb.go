@@ -142,12 +142,66 @@ func (InputType) EnumDescriptor() ([]byte, []int) {
 	return file_multiplayer_v1_player_proto_rawDescGZIP(), []int{1}
 }
 
+// What a player spends aloe on through ACTION_TYPE_INTERACT
+type AloeUse int32
+
+const (
+	AloeUse_ALOE_USE_UNSPECIFIED  AloeUse = 0 // Server picks: break freeze, else heal, else shield
+	AloeUse_ALOE_USE_BREAK_FREEZE AloeUse = 1 // Not allowed in freeze tag or battle royale, where the mode decides thaws
+	AloeUse_ALOE_USE_HEAL         AloeUse = 2
+	AloeUse_ALOE_USE_SHIELD       AloeUse = 3
+)
+
+// Enum value maps for AloeUse.
+var (
+	AloeUse_name = map[int32]string{
+		0: "ALOE_USE_UNSPECIFIED",
+		1: "ALOE_USE_BREAK_FREEZE",
+		2: "ALOE_USE_HEAL",
+		3: "ALOE_USE_SHIELD",
+	}
+	AloeUse_value = map[string]int32{
+		"ALOE_USE_UNSPECIFIED":  0,
+		"ALOE_USE_BREAK_FREEZE": 1,
+		"ALOE_USE_HEAL":         2,
+		"ALOE_USE_SHIELD":       3,
+	}
+)
+
+func (x AloeUse) Enum() *AloeUse {
+	p := new(AloeUse)
+	*p = x
+	return p
+}
+
+func (x AloeUse) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AloeUse) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_player_proto_enumTypes[2].Descriptor()
+}
+
+func (AloeUse) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_player_proto_enumTypes[2]
+}
+
+func (x AloeUse) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AloeUse.Descriptor instead.
+func (AloeUse) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_player_proto_rawDescGZIP(), []int{2}
+}
+
 type ActionType int32
 
 const (
-	ActionType_ACTION_TYPE_UNSPECIFIED  ActionType = 0
-	ActionType_ACTION_TYPE_THROW_POTION ActionType = 1
-	ActionType_ACTION_TYPE_INTERACT     ActionType = 2
+	ActionType_ACTION_TYPE_UNSPECIFIED   ActionType = 0
+	ActionType_ACTION_TYPE_THROW_POTION  ActionType = 1
+	ActionType_ACTION_TYPE_INTERACT      ActionType = 2 // Spends aloe, see AloeUse
+	ActionType_ACTION_TYPE_CAST_FIREBALL ActionType = 3 // Damages the first player it hits
 )
 
 // Enum value maps for ActionType.
@@ -156,11 +210,13 @@ var (
 		0: "ACTION_TYPE_UNSPECIFIED",
 		1: "ACTION_TYPE_THROW_POTION",
 		2: "ACTION_TYPE_INTERACT",
+		3: "ACTION_TYPE_CAST_FIREBALL",
 	}
 	ActionType_value = map[string]int32{
-		"ACTION_TYPE_UNSPECIFIED":  0,
-		"ACTION_TYPE_THROW_POTION": 1,
-		"ACTION_TYPE_INTERACT":     2,
+		"ACTION_TYPE_UNSPECIFIED":   0,
+		"ACTION_TYPE_THROW_POTION":  1,
+		"ACTION_TYPE_INTERACT":      2,
+		"ACTION_TYPE_CAST_FIREBALL": 3,
 	}
 )
 
@@ -175,11 +231,11 @@ func (x ActionType) String() string {
 }
 
 func (ActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_multiplayer_v1_player_proto_enumTypes[2].Descriptor()
+	return file_multiplayer_v1_player_proto_enumTypes[3].Descriptor()
 }
 
 func (ActionType) Type() protoreflect.EnumType {
-	return &file_multiplayer_v1_player_proto_enumTypes[2]
+	return &file_multiplayer_v1_player_proto_enumTypes[3]
 }
 
 func (x ActionType) Number() protoreflect.EnumNumber {
@@ -188,7 +244,7 @@ func (x ActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ActionType.Descriptor instead.
 func (ActionType) EnumDescriptor() ([]byte, []int) {
-	return file_multiplayer_v1_player_proto_rawDescGZIP(), []int{2}
+	return file_multiplayer_v1_player_proto_rawDescGZIP(), []int{3}
 }
 
 type PlayerEvent struct {
@@ -281,7 +337,8 @@ func (x *PlayerEvent) GetGameAction() *GameAction {
 type InputAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Input         InputType              `protobuf:"varint,1,opt,name=input,proto3,enum=multiplayer.v1.InputType" json:"input,omitempty"`
-	Pressed       bool                   `protobuf:"varint,2,opt,name=pressed,proto3" json:"pressed,omitempty"` // true = key down, false = key up
+	Pressed       bool                   `protobuf:"varint,2,opt,name=pressed,proto3" json:"pressed,omitempty"`   // true = key down, false = key up
+	Sequence      uint32                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"` // Client-assigned, increasing per input; echoed in PlayerState.last_processed_input
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -330,11 +387,19 @@ func (x *InputAction) GetPressed() bool {
 	return false
 }
 
+func (x *InputAction) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 // Game actions (fire, abilities, etc.)
 type GameAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Action        ActionType             `protobuf:"varint,1,opt,name=action,proto3,enum=multiplayer.v1.ActionType" json:"action,omitempty"`
-	Target        *Vector2               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // Optional target position (e.g., for aiming)
+	Target        *Vector2               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`                                               // Optional target position (e.g., for aiming)
+	AloeUse       AloeUse                `protobuf:"varint,3,opt,name=aloe_use,json=aloeUse,proto3,enum=multiplayer.v1.AloeUse" json:"aloe_use,omitempty"` // Used for INTERACT - what to spend aloe on
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -383,6 +448,13 @@ func (x *GameAction) GetTarget() *Vector2 {
 	return nil
 }
 
+func (x *GameAction) GetAloeUse() AloeUse {
+	if x != nil {
+		return x.AloeUse
+	}
+	return AloeUse_ALOE_USE_UNSPECIFIED
+}
+
 // Deprecated: Full input state - prefer InputAction for efficiency
 type PlayerInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -464,14 +536,16 @@ const file_multiplayer_v1_player_proto_rawDesc = "" +
 	"\x05input\x18\x04 \x01(\v2\x1b.multiplayer.v1.PlayerInputR\x05input\x12>\n" +
 	"\finput_action\x18\x05 \x01(\v2\x1b.multiplayer.v1.InputActionR\vinputAction\x12;\n" +
 	"\vgame_action\x18\x06 \x01(\v2\x1a.multiplayer.v1.GameActionR\n" +
-	"gameAction\"X\n" +
+	"gameAction\"t\n" +
 	"\vInputAction\x12/\n" +
 	"\x05input\x18\x01 \x01(\x0e2\x19.multiplayer.v1.InputTypeR\x05input\x12\x18\n" +
-	"\apressed\x18\x02 \x01(\bR\apressed\"q\n" +
+	"\apressed\x18\x02 \x01(\bR\apressed\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\rR\bsequence\"\xa5\x01\n" +
 	"\n" +
 	"GameAction\x122\n" +
 	"\x06action\x18\x01 \x01(\x0e2\x1a.multiplayer.v1.ActionTypeR\x06action\x12/\n" +
-	"\x06target\x18\x02 \x01(\v2\x17.multiplayer.v1.Vector2R\x06target\"\x7f\n" +
+	"\x06target\x18\x02 \x01(\v2\x17.multiplayer.v1.Vector2R\x06target\x122\n" +
+	"\baloe_use\x18\x03 \x01(\x0e2\x17.multiplayer.v1.AloeUseR\aaloeUse\"\x7f\n" +
 	"\vPlayerInput\x12\x17\n" +
 	"\amove_up\x18\x01 \x01(\bR\x06moveUp\x12\x1b\n" +
 	"\tmove_down\x18\x02 \x01(\bR\bmoveDown\x12\x1b\n" +
@@ -492,12 +566,18 @@ const file_multiplayer_v1_player_proto_rawDesc = "" +
 	"\x12INPUT_TYPE_MOVE_UP\x10\x01\x12\x18\n" +
 	"\x14INPUT_TYPE_MOVE_DOWN\x10\x02\x12\x18\n" +
 	"\x14INPUT_TYPE_MOVE_LEFT\x10\x03\x12\x19\n" +
-	"\x15INPUT_TYPE_MOVE_RIGHT\x10\x04*a\n" +
+	"\x15INPUT_TYPE_MOVE_RIGHT\x10\x04*f\n" +
+	"\aAloeUse\x12\x18\n" +
+	"\x14ALOE_USE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ALOE_USE_BREAK_FREEZE\x10\x01\x12\x11\n" +
+	"\rALOE_USE_HEAL\x10\x02\x12\x13\n" +
+	"\x0fALOE_USE_SHIELD\x10\x03*\x80\x01\n" +
 	"\n" +
 	"ActionType\x12\x1b\n" +
 	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18ACTION_TYPE_THROW_POTION\x10\x01\x12\x18\n" +
-	"\x14ACTION_TYPE_INTERACT\x10\x02B\xc6\x01\n" +
+	"\x14ACTION_TYPE_INTERACT\x10\x02\x12\x1d\n" +
+	"\x19ACTION_TYPE_CAST_FIREBALL\x10\x03B\xc6\x01\n" +
 	"\x12com.multiplayer.v1B\vPlayerProtoP\x01ZJgithub.com/sonastea/WizardWarriors/common/gen/multiplayer/v1;multiplayerv1\xa2\x02\x03MXX\xaa\x02\x0eMultiplayer.V1\xca\x02\x0eMultiplayer\\V1\xe2\x02\x1aMultiplayer\\V1\\GPBMetadata\xea\x02\x0fMultiplayer::V1b\x06proto3"
 
 var (
@@ -512,34 +592,36 @@ func file_multiplayer_v1_player_proto_rawDescGZIP() []byte {
 	return file_multiplayer_v1_player_proto_rawDescData
 }
 
-var file_multiplayer_v1_player_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_multiplayer_v1_player_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_multiplayer_v1_player_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_multiplayer_v1_player_proto_goTypes = []any{
 	(PlayerEventType)(0), // 0: multiplayer.v1.PlayerEventType
 	(InputType)(0),       // 1: multiplayer.v1.InputType
-	(ActionType)(0),      // 2: multiplayer.v1.ActionType
-	(*PlayerEvent)(nil),  // 3: multiplayer.v1.PlayerEvent
-	(*InputAction)(nil),  // 4: multiplayer.v1.InputAction
-	(*GameAction)(nil),   // 5: multiplayer.v1.GameAction
-	(*PlayerInput)(nil),  // 6: multiplayer.v1.PlayerInput
-	(*ID)(nil),           // 7: multiplayer.v1.ID
-	(*Vector2)(nil),      // 8: multiplayer.v1.Vector2
+	(AloeUse)(0),         // 2: multiplayer.v1.AloeUse
+	(ActionType)(0),      // 3: multiplayer.v1.ActionType
+	(*PlayerEvent)(nil),  // 4: multiplayer.v1.PlayerEvent
+	(*InputAction)(nil),  // 5: multiplayer.v1.InputAction
+	(*GameAction)(nil),   // 6: multiplayer.v1.GameAction
+	(*PlayerInput)(nil),  // 7: multiplayer.v1.PlayerInput
+	(*ID)(nil),           // 8: multiplayer.v1.ID
+	(*Vector2)(nil),      // 9: multiplayer.v1.Vector2
 }
 var file_multiplayer_v1_player_proto_depIdxs = []int32{
-	0, // 0: multiplayer.v1.PlayerEvent.type:type_name -> multiplayer.v1.PlayerEventType
-	7, // 1: multiplayer.v1.PlayerEvent.player_id:type_name -> multiplayer.v1.ID
-	8, // 2: multiplayer.v1.PlayerEvent.position:type_name -> multiplayer.v1.Vector2
-	6, // 3: multiplayer.v1.PlayerEvent.input:type_name -> multiplayer.v1.PlayerInput
-	4, // 4: multiplayer.v1.PlayerEvent.input_action:type_name -> multiplayer.v1.InputAction
-	5, // 5: multiplayer.v1.PlayerEvent.game_action:type_name -> multiplayer.v1.GameAction
-	1, // 6: multiplayer.v1.InputAction.input:type_name -> multiplayer.v1.InputType
-	2, // 7: multiplayer.v1.GameAction.action:type_name -> multiplayer.v1.ActionType
-	8, // 8: multiplayer.v1.GameAction.target:type_name -> multiplayer.v1.Vector2
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: multiplayer.v1.PlayerEvent.type:type_name -> multiplayer.v1.PlayerEventType
+	8,  // 1: multiplayer.v1.PlayerEvent.player_id:type_name -> multiplayer.v1.ID
+	9,  // 2: multiplayer.v1.PlayerEvent.position:type_name -> multiplayer.v1.Vector2
+	7,  // 3: multiplayer.v1.PlayerEvent.input:type_name -> multiplayer.v1.PlayerInput
+	5,  // 4: multiplayer.v1.PlayerEvent.input_action:type_name -> multiplayer.v1.InputAction
+	6,  // 5: multiplayer.v1.PlayerEvent.game_action:type_name -> multiplayer.v1.GameAction
+	1,  // 6: multiplayer.v1.InputAction.input:type_name -> multiplayer.v1.InputType
+	3,  // 7: multiplayer.v1.GameAction.action:type_name -> multiplayer.v1.ActionType
+	9,  // 8: multiplayer.v1.GameAction.target:type_name -> multiplayer.v1.Vector2
+	2,  // 9: multiplayer.v1.GameAction.aloe_use:type_name -> multiplayer.v1.AloeUse
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_multiplayer_v1_player_proto_init() }
@@ -553,7 +635,7 @@ func file_multiplayer_v1_player_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multiplayer_v1_player_proto_rawDesc), len(file_multiplayer_v1_player_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -81,6 +81,13 @@ export declare type InputAction = Message<"multiplayer.v1.InputAction"> & {
    * @generated from field: bool pressed = 2;
    */
   pressed: boolean;
+
+  /**
+   * Client-assigned, increasing per input; echoed in PlayerState.last_processed_input
+   *
+   * @generated from field: uint32 sequence = 3;
+   */
+  sequence: number;
 };
 
 /**
@@ -106,6 +113,13 @@ export declare type GameAction = Message<"multiplayer.v1.GameAction"> & {
    * @generated from field: multiplayer.v1.Vector2 target = 2;
    */
   target?: Vector2;
+
+  /**
+   * Used for INTERACT - what to spend aloe on
+   *
+   * @generated from field: multiplayer.v1.AloeUse aloe_use = 3;
+   */
+  aloeUse: AloeUse;
 };
 
 /**
@@ -246,6 +260,42 @@ export enum InputType {
  */
 export declare const InputTypeSchema: GenEnum<InputType>;
 
+/**
+ * What a player spends aloe on through ACTION_TYPE_INTERACT
+ *
+ * @generated from enum multiplayer.v1.AloeUse
+ */
+export enum AloeUse {
+  /**
+   * Server picks: break freeze, else heal, else shield
+   *
+   * @generated from enum value: ALOE_USE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Not allowed in freeze tag or battle royale, where the mode decides thaws
+   *
+   * @generated from enum value: ALOE_USE_BREAK_FREEZE = 1;
+   */
+  BREAK_FREEZE = 1,
+
+  /**
+   * @generated from enum value: ALOE_USE_HEAL = 2;
+   */
+  HEAL = 2,
+
+  /**
+   * @generated from enum value: ALOE_USE_SHIELD = 3;
+   */
+  SHIELD = 3,
+}
+
+/**
+ * Describes the enum multiplayer.v1.AloeUse.
+ */
+export declare const AloeUseSchema: GenEnum<AloeUse>;
+
 /**
  * @generated from enum multiplayer.v1.ActionType
  */
@@ -261,9 +311,18 @@ export enum ActionType {
   THROW_POTION = 1,
 
   /**
+   * Spends aloe, see AloeUse
+   *
    * @generated from enum value: ACTION_TYPE_INTERACT = 2;
    */
   INTERACT = 2,
+
+  /**
+   * Damages the first player it hits
+   *
+   * @generated from enum value: ACTION_TYPE_CAST_FIREBALL = 3;
+   */
+  CAST_FIREBALL = 3,
 }
 
 /**
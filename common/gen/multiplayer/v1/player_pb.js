@@ -9,7 +9,7 @@ import { file_multiplayer_v1_common } from "./common_pb";
  * Describes the file multiplayer/v1/player.proto.
  */
 export const file_multiplayer_v1_player = /*@__PURE__*/
-  fileDesc("ChttdWx0aXBsYXllci92MS9wbGF5ZXIucHJvdG8SDm11bHRpcGxheWVyLnYxIp4CCgtQbGF5ZXJFdmVudBItCgR0eXBlGAEgASgOMh8ubXVsdGlwbGF5ZXIudjEuUGxheWVyRXZlbnRUeXBlEiUKCXBsYXllcl9pZBgCIAEoCzISLm11bHRpcGxheWVyLnYxLklEEikKCHBvc2l0aW9uGAMgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIqCgVpbnB1dBgEIAEoCzIbLm11bHRpcGxheWVyLnYxLlBsYXllcklucHV0EjEKDGlucHV0X2FjdGlvbhgFIAEoCzIbLm11bHRpcGxheWVyLnYxLklucHV0QWN0aW9uEi8KC2dhbWVfYWN0aW9uGAYgASgLMhoubXVsdGlwbGF5ZXIudjEuR2FtZUFjdGlvbiJICgtJbnB1dEFjdGlvbhIoCgVpbnB1dBgBIAEoDjIZLm11bHRpcGxheWVyLnYxLklucHV0VHlwZRIPCgdwcmVzc2VkGAIgASgIImEKCkdhbWVBY3Rpb24SKgoGYWN0aW9uGAEgASgOMhoubXVsdGlwbGF5ZXIudjEuQWN0aW9uVHlwZRInCgZ0YXJnZXQYAiABKAsyFy5tdWx0aXBsYXllci52MS5WZWN0b3IyIlgKC1BsYXllcklucHV0Eg8KB21vdmVfdXAYASABKAgSEQoJbW92ZV9kb3duGAIgASgIEhEKCW1vdmVfbGVmdBgDIAEoCBISCgptb3ZlX3JpZ2h0GAQgASgIKv0BCg9QbGF5ZXJFdmVudFR5cGUSIQodUExBWUVSX0VWRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIaChZQTEFZRVJfRVZFTlRfVFlQRV9KT0lOEAESGwoXUExBWUVSX0VWRU5UX1RZUEVfTEVBVkUQAhIaChZQTEFZRVJfRVZFTlRfVFlQRV9NT1ZFEAMSGgoWUExBWUVSX0VWRU5UX1RZUEVfUkVBRBAEEhsKF1BMQVlFUl9FVkVOVF9UWVBFX1JFQURZEAUSGwoXUExBWUVSX0VWRU5UX1RZUEVfSU5QVVQQBhIcChhQTEFZRVJfRVZFTlRfVFlQRV9BQ1RJT04QByqOAQoJSW5wdXRUeXBlEhoKFklOUFVUX1RZUEVfVU5TUEVDSUZJRUQQABIWChJJTlBVVF9UWVBFX01PVkVfVVAQARIYChRJTlBVVF9UWVBFX01PVkVfRE9XThACEhgKFElOUFVUX1RZUEVfTU9WRV9MRUZUEAMSGQoVSU5QVVRfVFlQRV9NT1ZFX1JJR0hUEAQqYQoKQWN0aW9uVHlwZRIbChdBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEhwKGEFDVElPTl9UWVBFX1RIUk9XX1BPVElPThABEhgKFEFDVElPTl9UWVBFX0lOVEVSQUNUEAJCxgEKEmNvbS5tdWx0aXBsYXllci52MUILUGxheWVyUHJvdG9QAVpKZ2l0aHViLmNvbS9zb25hc3RlYS9XaXphcmRXYXJyaW9ycy9jb21tb24vZ2VuL211bHRpcGxheWVyL3YxO211bHRpcGxheWVydjGiAgNNWFiqAg5NdWx0aXBsYXllci5WMcoCDk11bHRpcGxheWVyXFYx4gIaTXVsdGlwbGF5ZXJcVjFcR1BCTWV0YWRhdGHqAg9NdWx0aXBsYXllcjo6VjFiBnByb3RvMw", [file_multiplayer_v1_common]);
+  fileDesc("ChttdWx0aXBsYXllci92MS9wbGF5ZXIucHJvdG8SDm11bHRpcGxheWVyLnYxIp4CCgtQbGF5ZXJFdmVudBItCgR0eXBlGAEgASgOMh8ubXVsdGlwbGF5ZXIudjEuUGxheWVyRXZlbnRUeXBlEiUKCXBsYXllcl9pZBgCIAEoCzISLm11bHRpcGxheWVyLnYxLklEEikKCHBvc2l0aW9uGAMgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIqCgVpbnB1dBgEIAEoCzIbLm11bHRpcGxheWVyLnYxLlBsYXllcklucHV0EjEKDGlucHV0X2FjdGlvbhgFIAEoCzIbLm11bHRpcGxheWVyLnYxLklucHV0QWN0aW9uEi8KC2dhbWVfYWN0aW9uGAYgASgLMhoubXVsdGlwbGF5ZXIudjEuR2FtZUFjdGlvbiJaCgtJbnB1dEFjdGlvbhIoCgVpbnB1dBgBIAEoDjIZLm11bHRpcGxheWVyLnYxLklucHV0VHlwZRIPCgdwcmVzc2VkGAIgASgIEhAKCHNlcXVlbmNlGAMgASgNIowBCgpHYW1lQWN0aW9uEioKBmFjdGlvbhgBIAEoDjIaLm11bHRpcGxheWVyLnYxLkFjdGlvblR5cGUSJwoGdGFyZ2V0GAIgASgLMhcubXVsdGlwbGF5ZXIudjEuVmVjdG9yMhIpCghhbG9lX3VzZRgDIAEoDjIXLm11bHRpcGxheWVyLnYxLkFsb2VVc2UiWAoLUGxheWVySW5wdXQSDwoHbW92ZV91cBgBIAEoCBIRCgltb3ZlX2Rvd24YAiABKAgSEQoJbW92ZV9sZWZ0GAMgASgIEhIKCm1vdmVfcmlnaHQYBCABKAgq/QEKD1BsYXllckV2ZW50VHlwZRIhCh1QTEFZRVJfRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhoKFlBMQVlFUl9FVkVOVF9UWVBFX0pPSU4QARIbChdQTEFZRVJfRVZFTlRfVFlQRV9MRUFWRRACEhoKFlBMQVlFUl9FVkVOVF9UWVBFX01PVkUQAxIaChZQTEFZRVJfRVZFTlRfVFlQRV9SRUFEEAQSGwoXUExBWUVSX0VWRU5UX1RZUEVfUkVBRFkQBRIbChdQTEFZRVJfRVZFTlRfVFlQRV9JTlBVVBAGEhwKGFBMQVlFUl9FVkVOVF9UWVBFX0FDVElPThAHKo4BCglJbnB1dFR5cGUSGgoWSU5QVVRfVFlQRV9VTlNQRUNJRklFRBAAEhYKEklOUFVUX1RZUEVfTU9WRV9VUBABEhgKFElOUFVUX1RZUEVfTU9WRV9ET1dOEAISGAoUSU5QVVRfVFlQRV9NT1ZFX0xFRlQQAxIZChVJTlBVVF9UWVBFX01PVkVfUklHSFQQBCpmCgdBbG9lVXNlEhgKFEFMT0VfVVNFX1VOU1BFQ0lGSUVEEAASGQoVQUxPRV9VU0VfQlJFQUtfRlJFRVpFEAESEQoNQUxPRV9VU0VfSEVBTBACEhMKD0FMT0VfVVNFX1NISUVMRBADKoABCgpBY3Rpb25UeXBlEhsKF0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYQUNUSU9OX1RZUEVfVEhST1dfUE9USU9OEAESGAoUQUNUSU9OX1RZUEVfSU5URVJBQ1QQAhIdChlBQ1RJT05fVFlQRV9DQVNUX0ZJUkVCQUxMEANCxgEKEmNvbS5tdWx0aXBsYXllci52MUILUGxheWVyUHJvdG9QAVpKZ2l0aHViLmNvbS9zb25hc3RlYS9XaXphcmRXYXJyaW9ycy9jb21tb24vZ2VuL211bHRpcGxheWVyL3YxO211bHRpcGxheWVydjGiAgNNWFiqAg5NdWx0aXBsYXllci5WMcoCDk11bHRpcGxheWVyXFYx4gIaTXVsdGlwbGF5ZXJcVjFcR1BCTWV0YWRhdGHqAg9NdWx0aXBsYXllcjo6VjFiBnByb3RvMw", [file_multiplayer_v1_common]);
 
 /**
  * Describes the message multiplayer.v1.PlayerEvent.
@@ -67,11 +67,25 @@ export const InputTypeSchema = /*@__PURE__*/
 export const InputType = /*@__PURE__*/
   tsEnum(InputTypeSchema);
 
+/**
+ * Describes the enum multiplayer.v1.AloeUse.
+ */
+export const AloeUseSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_player, 2);
+
+/**
+ * What a player spends aloe on through ACTION_TYPE_INTERACT
+ *
+ * @generated from enum multiplayer.v1.AloeUse
+ */
+export const AloeUse = /*@__PURE__*/
+  tsEnum(AloeUseSchema);
+
 /**
  * Describes the enum multiplayer.v1.ActionType.
  */
 export const ActionTypeSchema = /*@__PURE__*/
-  enumDesc(file_multiplayer_v1_player, 2);
+  enumDesc(file_multiplayer_v1_player, 3);
 
 /**
  * @generated from enum multiplayer.v1.ActionType
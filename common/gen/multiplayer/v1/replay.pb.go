@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: multiplayer/v1/replay.proto
+
+package multiplayerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReplayEventType int32
+
+const (
+	ReplayEventType_REPLAY_EVENT_TYPE_UNSPECIFIED ReplayEventType = 0
+	ReplayEventType_REPLAY_EVENT_TYPE_JOIN        ReplayEventType = 1 // A player or bot entered the game
+	ReplayEventType_REPLAY_EVENT_TYPE_LEAVE       ReplayEventType = 2 // A player left the game
+	ReplayEventType_REPLAY_EVENT_TYPE_IDLE        ReplayEventType = 3 // A disconnected player's inputs were released
+	ReplayEventType_REPLAY_EVENT_TYPE_INPUT       ReplayEventType = 4
+	ReplayEventType_REPLAY_EVENT_TYPE_ACTION      ReplayEventType = 5
+	ReplayEventType_REPLAY_EVENT_TYPE_SKIP        ReplayEventType = 6 // The simulation skipped ahead after falling behind
+	ReplayEventType_REPLAY_EVENT_TYPE_END         ReplayEventType = 7 // The room closed
+)
+
+// Enum value maps for ReplayEventType.
+var (
+	ReplayEventType_name = map[int32]string{
+		0: "REPLAY_EVENT_TYPE_UNSPECIFIED",
+		1: "REPLAY_EVENT_TYPE_JOIN",
+		2: "REPLAY_EVENT_TYPE_LEAVE",
+		3: "REPLAY_EVENT_TYPE_IDLE",
+		4: "REPLAY_EVENT_TYPE_INPUT",
+		5: "REPLAY_EVENT_TYPE_ACTION",
+		6: "REPLAY_EVENT_TYPE_SKIP",
+		7: "REPLAY_EVENT_TYPE_END",
+	}
+	ReplayEventType_value = map[string]int32{
+		"REPLAY_EVENT_TYPE_UNSPECIFIED": 0,
+		"REPLAY_EVENT_TYPE_JOIN":        1,
+		"REPLAY_EVENT_TYPE_LEAVE":       2,
+		"REPLAY_EVENT_TYPE_IDLE":        3,
+		"REPLAY_EVENT_TYPE_INPUT":       4,
+		"REPLAY_EVENT_TYPE_ACTION":      5,
+		"REPLAY_EVENT_TYPE_SKIP":        6,
+		"REPLAY_EVENT_TYPE_END":         7,
+	}
+)
+
+func (x ReplayEventType) Enum() *ReplayEventType {
+	p := new(ReplayEventType)
+	*p = x
+	return p
+}
+
+func (x ReplayEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplayEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_multiplayer_v1_replay_proto_enumTypes[0].Descriptor()
+}
+
+func (ReplayEventType) Type() protoreflect.EnumType {
+	return &file_multiplayer_v1_replay_proto_enumTypes[0]
+}
+
+func (x ReplayEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplayEventType.Descriptor instead.
+func (ReplayEventType) EnumDescriptor() ([]byte, []int) {
+	return file_multiplayer_v1_replay_proto_rawDescGZIP(), []int{0}
+}
+
+// Everything about a room needed to recreate its simulation
+type ReplayHeader struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RoomId             string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Seed               int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	MapPath            string                 `protobuf:"bytes,3,opt,name=map_path,json=mapPath,proto3" json:"map_path,omitempty"`
+	GameMode           string                 `protobuf:"bytes,4,opt,name=game_mode,json=gameMode,proto3" json:"game_mode,omitempty"`                                 // Config name of the mode, e.g. "ffa" or "koth_teams"
+	ItemSpawnTable     string                 `protobuf:"bytes,5,opt,name=item_spawn_table,json=itemSpawnTable,proto3" json:"item_spawn_table,omitempty"`             // Path of the spawn table, empty for the built-in one
+	WorldEventSchedule string                 `protobuf:"bytes,6,opt,name=world_event_schedule,json=worldEventSchedule,proto3" json:"world_event_schedule,omitempty"` // Path of the event schedule, empty for the built-in one
+	TickRateNs         int64                  `protobuf:"varint,7,opt,name=tick_rate_ns,json=tickRateNs,proto3" json:"tick_rate_ns,omitempty"`
+	StartedAtNs        int64                  `protobuf:"varint,8,opt,name=started_at_ns,json=startedAtNs,proto3" json:"started_at_ns,omitempty"` // Simulation start time (Unix ns)
+	// Contents of the files above as the room read them, so the replay does not
+	// depend on them staying unchanged. Older replays only have the paths.
+	MapData                []byte `protobuf:"bytes,9,opt,name=map_data,json=mapData,proto3" json:"map_data,omitempty"`
+	ItemSpawnTableData     []byte `protobuf:"bytes,10,opt,name=item_spawn_table_data,json=itemSpawnTableData,proto3" json:"item_spawn_table_data,omitempty"`             // Empty for the built-in table
+	WorldEventScheduleData []byte `protobuf:"bytes,11,opt,name=world_event_schedule_data,json=worldEventScheduleData,proto3" json:"world_event_schedule_data,omitempty"` // Empty for the built-in schedule
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReplayHeader) Reset() {
+	*x = ReplayHeader{}
+	mi := &file_multiplayer_v1_replay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayHeader) ProtoMessage() {}
+
+func (x *ReplayHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_replay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayHeader.ProtoReflect.Descriptor instead.
+func (*ReplayHeader) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_replay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReplayHeader) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReplayHeader) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *ReplayHeader) GetMapPath() string {
+	if x != nil {
+		return x.MapPath
+	}
+	return ""
+}
+
+func (x *ReplayHeader) GetGameMode() string {
+	if x != nil {
+		return x.GameMode
+	}
+	return ""
+}
+
+func (x *ReplayHeader) GetItemSpawnTable() string {
+	if x != nil {
+		return x.ItemSpawnTable
+	}
+	return ""
+}
+
+func (x *ReplayHeader) GetWorldEventSchedule() string {
+	if x != nil {
+		return x.WorldEventSchedule
+	}
+	return ""
+}
+
+func (x *ReplayHeader) GetTickRateNs() int64 {
+	if x != nil {
+		return x.TickRateNs
+	}
+	return 0
+}
+
+func (x *ReplayHeader) GetStartedAtNs() int64 {
+	if x != nil {
+		return x.StartedAtNs
+	}
+	return 0
+}
+
+func (x *ReplayHeader) GetMapData() []byte {
+	if x != nil {
+		return x.MapData
+	}
+	return nil
+}
+
+func (x *ReplayHeader) GetItemSpawnTableData() []byte {
+	if x != nil {
+		return x.ItemSpawnTableData
+	}
+	return nil
+}
+
+func (x *ReplayHeader) GetWorldEventScheduleData() []byte {
+	if x != nil {
+		return x.WorldEventScheduleData
+	}
+	return nil
+}
+
+// Something that changed the simulation from outside. Events with tick N happened
+// after N steps had run and are applied before step N+1.
+type ReplayEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tick          int64                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Type          ReplayEventType        `protobuf:"varint,2,opt,name=type,proto3,enum=multiplayer.v1.ReplayEventType" json:"type,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`                          // JOIN
+	Bot           bool                   `protobuf:"varint,5,opt,name=bot,proto3" json:"bot,omitempty"`                                   // JOIN
+	InputAction   *InputAction           `protobuf:"bytes,6,opt,name=input_action,json=inputAction,proto3" json:"input_action,omitempty"` // INPUT
+	GameAction    *GameAction            `protobuf:"bytes,7,opt,name=game_action,json=gameAction,proto3" json:"game_action,omitempty"`    // ACTION
+	SkippedNs     int64                  `protobuf:"varint,8,opt,name=skipped_ns,json=skippedNs,proto3" json:"skipped_ns,omitempty"`      // SKIP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayEvent) Reset() {
+	*x = ReplayEvent{}
+	mi := &file_multiplayer_v1_replay_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEvent) ProtoMessage() {}
+
+func (x *ReplayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_multiplayer_v1_replay_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEvent.ProtoReflect.Descriptor instead.
+func (*ReplayEvent) Descriptor() ([]byte, []int) {
+	return file_multiplayer_v1_replay_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplayEvent) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetType() ReplayEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ReplayEventType_REPLAY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ReplayEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *ReplayEvent) GetInputAction() *InputAction {
+	if x != nil {
+		return x.InputAction
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetGameAction() *GameAction {
+	if x != nil {
+		return x.GameAction
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetSkippedNs() int64 {
+	if x != nil {
+		return x.SkippedNs
+	}
+	return 0
+}
+
+var File_multiplayer_v1_replay_proto protoreflect.FileDescriptor
+
+const file_multiplayer_v1_replay_proto_rawDesc = "" +
+	"\n" +
+	"\x1bmultiplayer/v1/replay.proto\x12\x0emultiplayer.v1\x1a\x1bmultiplayer/v1/player.proto\"\x9e\x03\n" +
+	"\fReplayHeader\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12\x19\n" +
+	"\bmap_path\x18\x03 \x01(\tR\amapPath\x12\x1b\n" +
+	"\tgame_mode\x18\x04 \x01(\tR\bgameMode\x12(\n" +
+	"\x10item_spawn_table\x18\x05 \x01(\tR\x0eitemSpawnTable\x120\n" +
+	"\x14world_event_schedule\x18\x06 \x01(\tR\x12worldEventSchedule\x12 \n" +
+	"\ftick_rate_ns\x18\a \x01(\x03R\n" +
+	"tickRateNs\x12\"\n" +
+	"\rstarted_at_ns\x18\b \x01(\x03R\vstartedAtNs\x12\x19\n" +
+	"\bmap_data\x18\t \x01(\fR\amapData\x121\n" +
+	"\x15item_spawn_table_data\x18\n" +
+	" \x01(\fR\x12itemSpawnTableData\x129\n" +
+	"\x19world_event_schedule_data\x18\v \x01(\fR\x16worldEventScheduleData\"\xb9\x02\n" +
+	"\vReplayEvent\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\x03R\x04tick\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.multiplayer.v1.ReplayEventTypeR\x04type\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x10\n" +
+	"\x03bot\x18\x05 \x01(\bR\x03bot\x12>\n" +
+	"\finput_action\x18\x06 \x01(\v2\x1b.multiplayer.v1.InputActionR\vinputAction\x12;\n" +
+	"\vgame_action\x18\a \x01(\v2\x1a.multiplayer.v1.GameActionR\n" +
+	"gameAction\x12\x1d\n" +
+	"\n" +
+	"skipped_ns\x18\b \x01(\x03R\tskippedNs*\xfb\x01\n" +
+	"\x0fReplayEventType\x12!\n" +
+	"\x1dREPLAY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REPLAY_EVENT_TYPE_JOIN\x10\x01\x12\x1b\n" +
+	"\x17REPLAY_EVENT_TYPE_LEAVE\x10\x02\x12\x1a\n" +
+	"\x16REPLAY_EVENT_TYPE_IDLE\x10\x03\x12\x1b\n" +
+	"\x17REPLAY_EVENT_TYPE_INPUT\x10\x04\x12\x1c\n" +
+	"\x18REPLAY_EVENT_TYPE_ACTION\x10\x05\x12\x1a\n" +
+	"\x16REPLAY_EVENT_TYPE_SKIP\x10\x06\x12\x19\n" +
+	"\x15REPLAY_EVENT_TYPE_END\x10\aB\xc6\x01\n" +
+	"\x12com.multiplayer.v1B\vReplayProtoP\x01ZJgithub.com/sonastea/WizardWarriors/common/gen/multiplayer/v1;multiplayerv1\xa2\x02\x03MXX\xaa\x02\x0eMultiplayer.V1\xca\x02\x0eMultiplayer\\V1\xe2\x02\x1aMultiplayer\\V1\\GPBMetadata\xea\x02\x0fMultiplayer::V1b\x06proto3"
+
+var (
+	file_multiplayer_v1_replay_proto_rawDescOnce sync.Once
+	file_multiplayer_v1_replay_proto_rawDescData []byte
+)
+
+func file_multiplayer_v1_replay_proto_rawDescGZIP() []byte {
+	file_multiplayer_v1_replay_proto_rawDescOnce.Do(func() {
+		file_multiplayer_v1_replay_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_multiplayer_v1_replay_proto_rawDesc), len(file_multiplayer_v1_replay_proto_rawDesc)))
+	})
+	return file_multiplayer_v1_replay_proto_rawDescData
+}
+
+var file_multiplayer_v1_replay_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_multiplayer_v1_replay_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_multiplayer_v1_replay_proto_goTypes = []any{
+	(ReplayEventType)(0), // 0: multiplayer.v1.ReplayEventType
+	(*ReplayHeader)(nil), // 1: multiplayer.v1.ReplayHeader
+	(*ReplayEvent)(nil),  // 2: multiplayer.v1.ReplayEvent
+	(*InputAction)(nil),  // 3: multiplayer.v1.InputAction
+	(*GameAction)(nil),   // 4: multiplayer.v1.GameAction
+}
+var file_multiplayer_v1_replay_proto_depIdxs = []int32{
+	0, // 0: multiplayer.v1.ReplayEvent.type:type_name -> multiplayer.v1.ReplayEventType
+	3, // 1: multiplayer.v1.ReplayEvent.input_action:type_name -> multiplayer.v1.InputAction
+	4, // 2: multiplayer.v1.ReplayEvent.game_action:type_name -> multiplayer.v1.GameAction
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_multiplayer_v1_replay_proto_init() }
+func file_multiplayer_v1_replay_proto_init() {
+	if File_multiplayer_v1_replay_proto != nil {
+		return
+	}
+	file_multiplayer_v1_player_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multiplayer_v1_replay_proto_rawDesc), len(file_multiplayer_v1_replay_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_multiplayer_v1_replay_proto_goTypes,
+		DependencyIndexes: file_multiplayer_v1_replay_proto_depIdxs,
+		EnumInfos:         file_multiplayer_v1_replay_proto_enumTypes,
+		MessageInfos:      file_multiplayer_v1_replay_proto_msgTypes,
+	}.Build()
+	File_multiplayer_v1_replay_proto = out.File
+	file_multiplayer_v1_replay_proto_goTypes = nil
+	file_multiplayer_v1_replay_proto_depIdxs = nil
+}
@@ -0,0 +1,220 @@
+// @generated by protoc-gen-es v2.10.2
+// @generated from file multiplayer/v1/replay.proto (package multiplayer.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { InputAction, GameAction } from "./player_pb";
+
+/**
+ * Describes the file multiplayer/v1/replay.proto.
+ */
+export declare const file_multiplayer_v1_replay: GenFile;
+
+/**
+ * Everything about a room needed to recreate its simulation
+ *
+ * @generated from message multiplayer.v1.ReplayHeader
+ */
+export declare type ReplayHeader = Message<"multiplayer.v1.ReplayHeader"> & {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId: string;
+
+  /**
+   * @generated from field: int64 seed = 2;
+   */
+  seed: bigint;
+
+  /**
+   * @generated from field: string map_path = 3;
+   */
+  mapPath: string;
+
+  /**
+   * Config name of the mode, e.g. "ffa" or "koth_teams"
+   *
+   * @generated from field: string game_mode = 4;
+   */
+  gameMode: string;
+
+  /**
+   * Path of the spawn table, empty for the built-in one
+   *
+   * @generated from field: string item_spawn_table = 5;
+   */
+  itemSpawnTable: string;
+
+  /**
+   * Path of the event schedule, empty for the built-in one
+   *
+   * @generated from field: string world_event_schedule = 6;
+   */
+  worldEventSchedule: string;
+
+  /**
+   * @generated from field: int64 tick_rate_ns = 7;
+   */
+  tickRateNs: bigint;
+
+  /**
+   * Simulation start time (Unix ns)
+   *
+   * @generated from field: int64 started_at_ns = 8;
+   */
+  startedAtNs: bigint;
+
+  /**
+   * Contents of the files above as the room read them, so the replay does not
+   * depend on them staying unchanged. Older replays only have the paths.
+   *
+   * @generated from field: bytes map_data = 9;
+   */
+  mapData: Uint8Array;
+
+  /**
+   * Empty for the built-in table
+   *
+   * @generated from field: bytes item_spawn_table_data = 10;
+   */
+  itemSpawnTableData: Uint8Array;
+
+  /**
+   * Empty for the built-in schedule
+   *
+   * @generated from field: bytes world_event_schedule_data = 11;
+   */
+  worldEventScheduleData: Uint8Array;
+};
+
+/**
+ * Describes the message multiplayer.v1.ReplayHeader.
+ * Use `create(ReplayHeaderSchema)` to create a new message.
+ */
+export declare const ReplayHeaderSchema: GenMessage<ReplayHeader>;
+
+/**
+ * Something that changed the simulation from outside. Events with tick N happened
+ * after N steps had run and are applied before step N+1.
+ *
+ * @generated from message multiplayer.v1.ReplayEvent
+ */
+export declare type ReplayEvent = Message<"multiplayer.v1.ReplayEvent"> & {
+  /**
+   * @generated from field: int64 tick = 1;
+   */
+  tick: bigint;
+
+  /**
+   * @generated from field: multiplayer.v1.ReplayEventType type = 2;
+   */
+  type: ReplayEventType;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+
+  /**
+   * JOIN
+   *
+   * @generated from field: string username = 4;
+   */
+  username: string;
+
+  /**
+   * JOIN
+   *
+   * @generated from field: bool bot = 5;
+   */
+  bot: boolean;
+
+  /**
+   * INPUT
+   *
+   * @generated from field: multiplayer.v1.InputAction input_action = 6;
+   */
+  inputAction?: InputAction;
+
+  /**
+   * ACTION
+   *
+   * @generated from field: multiplayer.v1.GameAction game_action = 7;
+   */
+  gameAction?: GameAction;
+
+  /**
+   * SKIP
+   *
+   * @generated from field: int64 skipped_ns = 8;
+   */
+  skippedNs: bigint;
+};
+
+/**
+ * Describes the message multiplayer.v1.ReplayEvent.
+ * Use `create(ReplayEventSchema)` to create a new message.
+ */
+export declare const ReplayEventSchema: GenMessage<ReplayEvent>;
+
+/**
+ * @generated from enum multiplayer.v1.ReplayEventType
+ */
+export enum ReplayEventType {
+  /**
+   * @generated from enum value: REPLAY_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A player or bot entered the game
+   *
+   * @generated from enum value: REPLAY_EVENT_TYPE_JOIN = 1;
+   */
+  JOIN = 1,
+
+  /**
+   * A player left the game
+   *
+   * @generated from enum value: REPLAY_EVENT_TYPE_LEAVE = 2;
+   */
+  LEAVE = 2,
+
+  /**
+   * A disconnected player's inputs were released
+   *
+   * @generated from enum value: REPLAY_EVENT_TYPE_IDLE = 3;
+   */
+  IDLE = 3,
+
+  /**
+   * @generated from enum value: REPLAY_EVENT_TYPE_INPUT = 4;
+   */
+  INPUT = 4,
+
+  /**
+   * @generated from enum value: REPLAY_EVENT_TYPE_ACTION = 5;
+   */
+  ACTION = 5,
+
+  /**
+   * The simulation skipped ahead after falling behind
+   *
+   * @generated from enum value: REPLAY_EVENT_TYPE_SKIP = 6;
+   */
+  SKIP = 6,
+
+  /**
+   * The room closed
+   *
+   * @generated from enum value: REPLAY_EVENT_TYPE_END = 7;
+   */
+  END = 7,
+}
+
+/**
+ * Describes the enum multiplayer.v1.ReplayEventType.
+ */
+export declare const ReplayEventTypeSchema: GenEnum<ReplayEventType>;
+
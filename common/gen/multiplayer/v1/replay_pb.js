@@ -0,0 +1,39 @@
+// @generated by protoc-gen-es v2.10.2
+// @generated from file multiplayer/v1/replay.proto (package multiplayer.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_multiplayer_v1_player } from "./player_pb";
+
+/**
+ * Describes the file multiplayer/v1/replay.proto.
+ */
+export const file_multiplayer_v1_replay = /*@__PURE__*/
+  fileDesc("ChttdWx0aXBsYXllci92MS9yZXBsYXkucHJvdG8SDm11bHRpcGxheWVyLnYxIosCCgxSZXBsYXlIZWFkZXISDwoHcm9vbV9pZBgBIAEoCRIMCgRzZWVkGAIgASgDEhAKCG1hcF9wYXRoGAMgASgJEhEKCWdhbWVfbW9kZRgEIAEoCRIYChBpdGVtX3NwYXduX3RhYmxlGAUgASgJEhwKFHdvcmxkX2V2ZW50X3NjaGVkdWxlGAYgASgJEhQKDHRpY2tfcmF0ZV9ucxgHIAEoAxIVCg1zdGFydGVkX2F0X25zGAggASgDEhAKCG1hcF9kYXRhGAkgASgMEh0KFWl0ZW1fc3Bhd25fdGFibGVfZGF0YRgKIAEoDBIhChl3b3JsZF9ldmVudF9zY2hlZHVsZV9kYXRhGAsgASgMIvIBCgtSZXBsYXlFdmVudBIMCgR0aWNrGAEgASgDEi0KBHR5cGUYAiABKA4yHy5tdWx0aXBsYXllci52MS5SZXBsYXlFdmVudFR5cGUSDwoHdXNlcl9pZBgDIAEoCRIQCgh1c2VybmFtZRgEIAEoCRILCgNib3QYBSABKAgSMQoMaW5wdXRfYWN0aW9uGAYgASgLMhsubXVsdGlwbGF5ZXIudjEuSW5wdXRBY3Rpb24SLwoLZ2FtZV9hY3Rpb24YByABKAsyGi5tdWx0aXBsYXllci52MS5HYW1lQWN0aW9uEhIKCnNraXBwZWRfbnMYCCABKAMq+wEKD1JlcGxheUV2ZW50VHlwZRIhCh1SRVBMQVlfRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhoKFlJFUExBWV9FVkVOVF9UWVBFX0pPSU4QARIbChdSRVBMQVlfRVZFTlRfVFlQRV9MRUFWRRACEhoKFlJFUExBWV9FVkVOVF9UWVBFX0lETEUQAxIbChdSRVBMQVlfRVZFTlRfVFlQRV9JTlBVVBAEEhwKGFJFUExBWV9FVkVOVF9UWVBFX0FDVElPThAFEhoKFlJFUExBWV9FVkVOVF9UWVBFX1NLSVAQBhIZChVSRVBMQVlfRVZFTlRfVFlQRV9FTkQQB0LGAQoSY29tLm11bHRpcGxheWVyLnYxQgtSZXBsYXlQcm90b1ABWkpnaXRodWIuY29tL3NvbmFzdGVhL1dpemFyZFdhcnJpb3JzL2NvbW1vbi9nZW4vbXVsdGlwbGF5ZXIvdjE7bXVsdGlwbGF5ZXJ2MaICA01YWKoCDk11bHRpcGxheWVyLlYxygIOTXVsdGlwbGF5ZXJcVjHiAhpNdWx0aXBsYXllclxWMVxHUEJNZXRhZGF0YeoCD011bHRpcGxheWVyOjpWMWIGcHJvdG8z", [file_multiplayer_v1_player]);
+
+/**
+ * Describes the message multiplayer.v1.ReplayHeader.
+ * Use `create(ReplayHeaderSchema)` to create a new message.
+ */
+export const ReplayHeaderSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_replay, 0);
+
+/**
+ * Describes the message multiplayer.v1.ReplayEvent.
+ * Use `create(ReplayEventSchema)` to create a new message.
+ */
+export const ReplayEventSchema = /*@__PURE__*/
+  messageDesc(file_multiplayer_v1_replay, 1);
+
+/**
+ * Describes the enum multiplayer.v1.ReplayEventType.
+ */
+export const ReplayEventTypeSchema = /*@__PURE__*/
+  enumDesc(file_multiplayer_v1_replay, 0);
+
+/**
+ * @generated from enum multiplayer.v1.ReplayEventType
+ */
+export const ReplayEventType = /*@__PURE__*/
+  tsEnum(ReplayEventTypeSchema);
+
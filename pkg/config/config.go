@@ -17,6 +17,9 @@ type Config struct {
 	IdleTimeout   time.Duration
 	SessionMaxAge int
 
+	// SessionResumeGrace is how long in seconds a dropped player is kept for reconnecting
+	SessionResumeGrace int
+
 	AllowedOrigins []string
 	Debug          bool
 	LogLevel       string
@@ -24,7 +27,21 @@ type Config struct {
 	RedisURL       string
 	RedisOpts      *redis.Options
 	MapPath        string
-	IsAPIServer    bool
+	// ItemSpawnTable is a JSON spawn table for map items, empty uses the built-in table
+	ItemSpawnTable string
+	// WorldEventSchedule is a JSON schedule of world events, empty runs only quicksand
+	WorldEventSchedule string
+	// GameMode is the mode new rooms play: ffa, freeze_tag, ctf, koth, koth_teams or battle_royale
+	GameMode string
+	// SimulationSeed seeds every room's random source, 0 picks a new seed per room
+	SimulationSeed int64
+	// SpectatorDelay is how many seconds behind live play spectators watch, 0 sends it live
+	SpectatorDelay int
+	// ReplayDir is where rooms record replays of their matches, empty disables recording
+	ReplayDir   string
+	MaxRooms    int
+	ViewRadius  int
+	IsAPIServer bool
 }
 
 // Load parses the command-line arguments into the Config struct
@@ -36,7 +53,16 @@ func (c *Config) Load(args []string) error {
 	dbConnDefault := envOrDefault("DATABASE_URL", "postgresql://postgres:postgres@db/wizardwarriors")
 	redisURLDefault := envOrDefault("REDIS_URL", "redis://localhost:6379/0")
 	sessionMaxAgeDefault := envOrDefaultInt("SESSION_MAX_AGE", 86400)
+	sessionResumeGraceDefault := envOrDefaultInt("SESSION_RESUME_GRACE", 30)
 	mapPathDefault := envOrDefault("MAP_PATH", "pkg/hub/assets/multiplayer_map.json")
+	itemSpawnTableDefault := envOrDefault("ITEM_SPAWN_TABLE", "")
+	worldEventScheduleDefault := envOrDefault("WORLD_EVENT_SCHEDULE", "")
+	gameModeDefault := envOrDefault("GAME_MODE", "ffa")
+	simulationSeedDefault := envOrDefaultInt("SIMULATION_SEED", 0)
+	replayDirDefault := envOrDefault("REPLAY_DIR", "")
+	maxRoomsDefault := envOrDefaultInt("MAX_ROOMS", 8)
+	viewRadiusDefault := envOrDefaultInt("VIEW_RADIUS", 900)
+	spectatorDelayDefault := envOrDefaultInt("SPECTATOR_DELAY", 10)
 	apiServerDefault := envOrDefaultBool("API_SERVER", false)
 	allowedOriginsDefault := envOrDefault("ALLOWED_ORIGINS", "http://ww.dev.localhost,http://localhost:3000")
 
@@ -45,7 +71,16 @@ func (c *Config) Load(args []string) error {
 	fs.StringVar(&c.DBConnURI, "DATABASE_URL", dbConnDefault, "database connection uri")
 	fs.StringVar(&c.RedisURL, "REDIS_URL", redisURLDefault, "redis url")
 	fs.IntVar(&c.SessionMaxAge, "SESSION_MAX_AGE", sessionMaxAgeDefault, "session cookie max age in seconds (default: 86400 = 24 hours)")
+	fs.IntVar(&c.SessionResumeGrace, "SESSION_RESUME_GRACE", sessionResumeGraceDefault, "seconds a disconnected player is kept in the game for reconnecting (0 disables)")
 	fs.StringVar(&c.MapPath, "MAP_PATH", mapPathDefault, "path to the game map JSON file")
+	fs.StringVar(&c.ItemSpawnTable, "ITEM_SPAWN_TABLE", itemSpawnTableDefault, "path to an item spawn table JSON file (empty uses the built-in table)")
+	fs.StringVar(&c.WorldEventSchedule, "WORLD_EVENT_SCHEDULE", worldEventScheduleDefault, "path to a world event schedule JSON file (empty runs only quicksand)")
+	fs.StringVar(&c.GameMode, "GAME_MODE", gameModeDefault, "game mode for new rooms: ffa, freeze_tag, ctf, koth, koth_teams or battle_royale")
+	fs.Int64Var(&c.SimulationSeed, "SIMULATION_SEED", int64(simulationSeedDefault), "seed for every room's random source, for reproducible matches (0 picks a new seed per room)")
+	fs.StringVar(&c.ReplayDir, "REPLAY_DIR", replayDirDefault, "directory to record match replays into (empty disables recording)")
+	fs.IntVar(&c.MaxRooms, "MAX_ROOMS", maxRoomsDefault, "maximum number of concurrent game rooms per game server")
+	fs.IntVar(&c.ViewRadius, "VIEW_RADIUS", viewRadiusDefault, "radius in world pixels around a player within which entities are sent to them")
+	fs.IntVar(&c.SpectatorDelay, "SPECTATOR_DELAY", spectatorDelayDefault, "seconds spectators watch behind live play so they cannot scout for players (0 sends it live)")
 	fs.BoolVar(&c.IsAPIServer, "API_SERVER", apiServerDefault, "run as API server (disables game-specific features like pub/sub and game state)")
 
 	var allowedOrigins string
@@ -0,0 +1,154 @@
+package hub
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	FreezePotionCooldown         = 750 * time.Millisecond
+	FreezePotionRange    float32 = 450
+	FireballCooldown             = 600 * time.Millisecond
+	FireballRange        float32 = 500
+)
+
+// Ability is a player action validated and applied by the server
+type Ability struct {
+	Name     string
+	Cooldown time.Duration
+	Cost     int     // Aloe spent per use
+	Range    float32 // Max cast distance, farther targets are pulled in. 0 means unlimited
+
+	// Effect applies the ability with gsm.mu held. Returning false means nothing
+	// happened and no cooldown or cost is charged.
+	Effect func(gsm *GameStateManager, caster *PlayerState, targetX, targetY float32) bool
+}
+
+var abilities = make(map[multiplayerv1.ActionType]*Ability)
+
+// RegisterAbility makes an ability usable through the matching ActionType
+func RegisterAbility(action multiplayerv1.ActionType, ability *Ability) {
+	if _, exists := abilities[action]; exists {
+		panic(fmt.Sprintf("ability already registered for %v", action))
+	}
+	abilities[action] = ability
+}
+
+func init() {
+	RegisterAbility(multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION, &Ability{
+		Name:     "Freeze Potion",
+		Cooldown: FreezePotionCooldown,
+		Range:    FreezePotionRange,
+		Effect:   projectileEffect(ProjectileTypeFreezePotion),
+	})
+	RegisterAbility(multiplayerv1.ActionType_ACTION_TYPE_CAST_FIREBALL, &Ability{
+		Name:     "Fireball",
+		Cooldown: FireballCooldown,
+		Range:    FireballRange,
+		Effect:   projectileEffect(ProjectileTypeFireball),
+	})
+}
+
+// projectileEffect launches a projectile from the caster toward the target
+func projectileEffect(projectileType ProjectileType) func(*GameStateManager, *PlayerState, float32, float32) bool {
+	return func(gsm *GameStateManager, caster *PlayerState, targetX, targetY float32) bool {
+		return gsm.projectileManager.Spawn(projectileType, caster.UserID, caster.X, caster.Y, targetX, targetY) != ""
+	}
+}
+
+// lookupAbility returns the ability bound to an action, if any
+func lookupAbility(action multiplayerv1.ActionType) (*Ability, bool) {
+	ability, ok := abilities[action]
+	return ability, ok
+}
+
+// UseAbility casts an ability for a player if they can act, it is off cooldown
+// and they can afford it. Returns whether the ability was used.
+func (gsm *GameStateManager) UseAbility(userID string, action multiplayerv1.ActionType, targetX, targetY float32) bool {
+	ability, ok := lookupAbility(action)
+	if !ok {
+		return false
+	}
+	// NaN and infinite targets slip past the range clamp and poison positions
+	if !isFinite(targetX) || !isFinite(targetY) {
+		logger.Debug("Player %s aimed %s at a non-finite target (%v, %v)", userID, ability.Name, targetX, targetY)
+		return false
+	}
+
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	player, exists := gsm.players[userID]
+	if !exists || player.IsDisabled() || !gsm.matchManager.ActionsAllowed() {
+		return false
+	}
+
+	now := gsm.now()
+	if now.Before(player.Cooldowns[action]) {
+		logger.Debug("Player %s tried %s while on cooldown", userID, ability.Name)
+		return false
+	}
+	if player.AloeCount < ability.Cost {
+		return false
+	}
+
+	targetX, targetY = clampToRange(player.X, player.Y, targetX, targetY, ability.Range)
+	if !ability.Effect(gsm, player, targetX, targetY) {
+		return false
+	}
+
+	player.AloeCount -= ability.Cost
+	if ability.Cooldown > 0 {
+		if player.Cooldowns == nil {
+			player.Cooldowns = make(map[multiplayerv1.ActionType]time.Time)
+		}
+		player.Cooldowns[action] = now.Add(ability.Cooldown)
+	}
+	return true
+}
+
+// clampToRange pulls a target back along the aim direction so it is at most maxRange away
+func clampToRange(fromX, fromY, toX, toY, maxRange float32) (float32, float32) {
+	if maxRange <= 0 {
+		return toX, toY
+	}
+
+	dx := toX - fromX
+	dy := toY - fromY
+	dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if dist <= maxRange {
+		return toX, toY
+	}
+
+	scale := maxRange / dist
+	return fromX + dx*scale, fromY + dy*scale
+}
+
+// isFinite reports whether v is neither NaN nor infinite
+func isFinite(v float32) bool {
+	f := float64(v)
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// buildCooldowns lists the abilities a player is still waiting on, ordered by action
+func buildCooldowns(player *PlayerState, now time.Time) []*multiplayerv1.AbilityCooldown {
+	var cooldowns []*multiplayerv1.AbilityCooldown
+	for action, readyAt := range player.Cooldowns {
+		if now.Before(readyAt) {
+			cooldowns = append(cooldowns, &multiplayerv1.AbilityCooldown{
+				Action:      action,
+				RemainingMs: readyAt.Sub(now).Milliseconds(),
+			})
+		}
+	}
+
+	slices.SortFunc(cooldowns, func(a, b *multiplayerv1.AbilityCooldown) int {
+		return int(a.Action) - int(b.Action)
+	})
+	return cooldowns
+}
@@ -0,0 +1,81 @@
+package hub
+
+import (
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	AloeBreakFreezeCost = 2
+	AloeHealCost        = 1
+	AloeHealAmount      = 25
+	AloeShieldCost      = 3
+	AloeShieldDuration  = 3 * time.Second
+)
+
+// ConsumeAloe spends a player's aloe on the requested use. An unspecified use picks
+// whatever helps most: breaking a freeze, then healing, then a shield.
+// Returns whether any aloe was spent.
+func (gsm *GameStateManager) ConsumeAloe(userID string, use multiplayerv1.AloeUse) bool {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	player, exists := gsm.players[userID]
+	if !exists || player.Eliminated || !gsm.matchManager.ActionsAllowed() {
+		return false
+	}
+
+	now := gsm.now()
+	if use == multiplayerv1.AloeUse_ALOE_USE_UNSPECIFIED {
+		use = preferredAloeUse(player)
+	}
+
+	// Only breaking free is possible while frozen
+	if player.ActionsBlocked() && use != multiplayerv1.AloeUse_ALOE_USE_BREAK_FREEZE {
+		return false
+	}
+
+	var cost int
+	switch use {
+	case multiplayerv1.AloeUse_ALOE_USE_BREAK_FREEZE:
+		cost = AloeBreakFreezeCost
+		if !player.HasStatus(StatusFrozen) || player.AloeCount < cost || !gsm.canBreakFreeze() {
+			return false
+		}
+		gsm.expireStatus(player, StatusFrozen, now)
+
+	case multiplayerv1.AloeUse_ALOE_USE_HEAL:
+		cost = AloeHealCost
+		if player.Health >= PlayerMaxHealth || player.AloeCount < cost {
+			return false
+		}
+		player.Health = min(PlayerMaxHealth, player.Health+AloeHealAmount)
+
+	case multiplayerv1.AloeUse_ALOE_USE_SHIELD:
+		cost = AloeShieldCost
+		if player.AloeCount < cost || !gsm.applyStatus(player, StatusShield, now, userID) {
+			return false
+		}
+
+	default:
+		return false
+	}
+
+	player.AloeCount -= cost
+	logger.Debug("Player %s spent %d aloe on %v, %d left", userID, cost, use, player.AloeCount)
+	return true
+}
+
+// preferredAloeUse picks the most useful thing a player can currently spend aloe on
+func preferredAloeUse(player *PlayerState) multiplayerv1.AloeUse {
+	switch {
+	case player.HasStatus(StatusFrozen):
+		return multiplayerv1.AloeUse_ALOE_USE_BREAK_FREEZE
+	case player.Health < PlayerMaxHealth:
+		return multiplayerv1.AloeUse_ALOE_USE_HEAL
+	default:
+		return multiplayerv1.AloeUse_ALOE_USE_SHIELD
+	}
+}
@@ -0,0 +1,238 @@
+package hub
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	BRStages                 = 5
+	BRStageWait              = 20 * time.Second // Time the zone holds still before each shrink
+	BRStageShrink            = 12 * time.Second // Time each shrink takes
+	BRShrinkFactor   float32 = 0.55             // Each circle's radius relative to the one before
+	BRZoneTick               = 2 * time.Second  // How often players outside the zone gain a stack
+	BRMaxZoneStacks          = 5                // Stacks at which the next tick eliminates
+	BRZoneSlowdown   float32 = 0.8              // Speed multiplier per stack
+	BRCenterAttempts         = 20
+)
+
+// safeCircle is one circle of the battle royale zone schedule
+type safeCircle struct {
+	X, Y   float32
+	Radius float32
+}
+
+func (c safeCircle) contains(x, y float32) bool {
+	dx := x - c.X
+	dy := y - c.Y
+	return dx*dx+dy*dy <= c.Radius*c.Radius
+}
+
+// BattleRoyale is free-for-all inside a safe zone that shrinks in stages. Players
+// outside it are slowed a little more every BRZoneTick and eventually eliminated.
+// Freezes last the whole round, nobody respawns, and the last player neither frozen
+// nor eliminated wins.
+type BattleRoyale struct {
+	circles      []safeCircle // circles[0] covers the map, circles[i] is where stage i shrinks to
+	startedAt    time.Time
+	nextZoneTick map[string]time.Time
+	winnerID     string
+}
+
+func NewBattleRoyale() *BattleRoyale {
+	return &BattleRoyale{
+		nextZoneTick: make(map[string]time.Time),
+	}
+}
+
+func (*BattleRoyale) Type() multiplayerv1.GameMode {
+	return multiplayerv1.GameMode_GAME_MODE_BATTLE_ROYALE
+}
+
+func (*BattleRoyale) Teams() int      { return 0 }
+func (*BattleRoyale) ScoreLimit() int { return 0 }
+func (*BattleRoyale) Respawns() bool  { return false }
+
+// FreezeDuration keeps frozen players out for the rest of the round
+func (*BattleRoyale) FreezeDuration() time.Duration {
+	return MatchRoundDuration
+}
+
+func (br *BattleRoyale) StartRound(gsm *GameStateManager, now time.Time) {
+	br.circles = br.generateCircles(gsm.gameMap, gsm.rng)
+	br.startedAt = now
+	br.nextZoneTick = make(map[string]time.Time)
+	br.winnerID = ""
+	logger.Info("[BR] Zone starts at (%.1f, %.1f) r=%.1f, ends at (%.1f, %.1f) r=%.1f",
+		br.circles[0].X, br.circles[0].Y, br.circles[0].Radius,
+		br.circles[BRStages].X, br.circles[BRStages].Y, br.circles[BRStages].Radius)
+}
+
+// generateCircles builds the zone schedule. The first circle covers the whole map and
+// every later one sits inside the one before it, centered on passable ground.
+func (br *BattleRoyale) generateCircles(gameMap *GameMap, rng *rand.Rand) []safeCircle {
+	width, height := gameMap.PixelWidth, gameMap.PixelHeight
+	first := safeCircle{X: width / 2, Y: height / 2, Radius: float32(math.Hypot(float64(width), float64(height))) / 2}
+	if center, ok := gameMap.NearestPassablePoint(first.X, first.Y); ok {
+		first.X, first.Y = center.X, center.Y
+	}
+
+	circles := []safeCircle{first}
+	for range BRStages {
+		prev := circles[len(circles)-1]
+		next := safeCircle{X: prev.X, Y: prev.Y, Radius: prev.Radius * BRShrinkFactor}
+		maxOffset := prev.Radius - next.Radius
+
+		for range BRCenterAttempts {
+			angle := rng.Float64() * 2 * math.Pi
+			offset := float32(math.Sqrt(rng.Float64())) * maxOffset
+			center, ok := gameMap.NearestPassablePoint(
+				prev.X+offset*float32(math.Cos(angle)),
+				prev.Y+offset*float32(math.Sin(angle)),
+			)
+			if ok && distance(prev.X, prev.Y, center.X, center.Y) <= maxOffset {
+				next.X, next.Y = center.X, center.Y
+				break
+			}
+		}
+		circles = append(circles, next)
+	}
+	return circles
+}
+
+// zone returns the circle in effect at now, the stage it belongs to and when that stage's shrink starts and ends
+func (br *BattleRoyale) zone(now time.Time) (current safeCircle, stage int, shrinksAt, shrinkEndsAt time.Time) {
+	stageLength := BRStageWait + BRStageShrink
+	elapsed := max(now.Sub(br.startedAt), 0)
+	stage = min(int(elapsed/stageLength)+1, BRStages)
+
+	stageStart := br.startedAt.Add(time.Duration(stage-1) * stageLength)
+	shrinksAt = stageStart.Add(BRStageWait)
+	shrinkEndsAt = shrinksAt.Add(BRStageShrink)
+
+	from, to := br.circles[stage-1], br.circles[stage]
+	progress := float32(0)
+	if now.After(shrinksAt) {
+		progress = min(float32(now.Sub(shrinksAt))/float32(BRStageShrink), 1)
+	}
+	current = safeCircle{
+		X:      from.X + (to.X-from.X)*progress,
+		Y:      from.Y + (to.Y-from.Y)*progress,
+		Radius: from.Radius + (to.Radius-from.Radius)*progress,
+	}
+	return current, stage, shrinksAt, shrinkEndsAt
+}
+
+func (br *BattleRoyale) Update(gsm *GameStateManager, now time.Time) (int, bool) {
+	if br.circles == nil || !gsm.matchManager.IsLive() {
+		return 0, false
+	}
+
+	current, _, _, _ := br.zone(now)
+	for _, player := range inIDOrder(gsm.players) {
+		if player.Eliminated {
+			continue
+		}
+		if current.contains(player.X, player.Y) {
+			delete(br.nextZoneTick, player.UserID)
+			continue
+		}
+		br.punishOutside(gsm, player, now)
+	}
+
+	return br.lastStanding(gsm)
+}
+
+// punishOutside slows a player outside the zone a little more every BRZoneTick,
+// eliminating them once they are at the slowest
+func (br *BattleRoyale) punishOutside(gsm *GameStateManager, player *PlayerState, now time.Time) {
+	next, ticking := br.nextZoneTick[player.UserID]
+	if ticking && now.Before(next) {
+		return
+	}
+	br.nextZoneTick[player.UserID] = now.Add(BRZoneTick)
+
+	if effect := player.status(StatusOutsideZone); effect != nil && effect.Stacks >= BRMaxZoneStacks {
+		delete(br.nextZoneTick, player.UserID)
+		gsm.eliminatePlayer(player, "")
+		logger.Info("[BR] Player %s eliminated outside the zone", player.UserID)
+		return
+	}
+	gsm.applyStatus(player, StatusOutsideZone, now, "")
+}
+
+// lastStanding ends the round once at most one player is neither frozen nor eliminated
+func (br *BattleRoyale) lastStanding(gsm *GameStateManager) (int, bool) {
+	if len(gsm.players) < 2 {
+		return 0, false
+	}
+
+	var standing []*PlayerState
+	for _, player := range gsm.players {
+		if !player.IsDisabled() {
+			standing = append(standing, player)
+		}
+	}
+	if len(standing) > 1 {
+		return 0, false
+	}
+
+	if len(standing) == 1 {
+		br.winnerID = standing[0].UserID
+	}
+	return 0, true
+}
+
+// KeepsFreezes holds frozen players out for the rest of the round
+func (*BattleRoyale) KeepsFreezes() bool { return true }
+
+// RoundWinner is the last player standing, if the round ended with one
+func (br *BattleRoyale) RoundWinner() (string, bool) {
+	return br.winnerID, br.winnerID != ""
+}
+
+func (br *BattleRoyale) Reset(gsm *GameStateManager) {
+	br.circles = nil
+	br.startedAt = time.Time{}
+	br.nextZoneTick = make(map[string]time.Time)
+	br.winnerID = ""
+}
+
+// BotGoal sends bots toward the next circle, urgently once the zone has caught up with them
+func (br *BattleRoyale) BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool) {
+	bot, exists := gsm.players[botID]
+	if !exists || br.circles == nil {
+		return BotGoal{}, false
+	}
+
+	now := gsm.now()
+	current, stage, _, _ := br.zone(now)
+	next := br.circles[stage]
+	if next.contains(bot.X, bot.Y) {
+		return BotGoal{}, false
+	}
+	return BotGoal{X: next.X, Y: next.Y, Urgent: !current.contains(bot.X, bot.Y)}, true
+}
+
+func (br *BattleRoyale) Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time) {
+	if br.circles == nil {
+		return
+	}
+
+	current, stage, shrinksAt, shrinkEndsAt := br.zone(now)
+	next := br.circles[stage]
+	state.SafeZone = &multiplayerv1.SafeZone{
+		Center:         &multiplayerv1.Vector2{X: current.X, Y: current.Y},
+		Radius:         current.Radius,
+		NextCenter:     &multiplayerv1.Vector2{X: next.X, Y: next.Y},
+		NextRadius:     next.Radius,
+		Stage:          int32(stage),
+		Stages:         BRStages,
+		ShrinksAtMs:    unixMillis(shrinksAt),
+		ShrinkEndsAtMs: unixMillis(shrinkEndsAt),
+	}
+}
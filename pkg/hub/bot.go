@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
 	"github.com/sonastea/WizardWarriors/pkg/logger"
 )
 
@@ -30,6 +31,7 @@ const (
 	BotStuckMoveMin           = 3.0   // Minimum movement per tick to not be considered stuck
 	BotDisengageCooldownMs    = 4000  // After freezing a target, disengage for this long before targeting again
 	BotDisengageDistance      = 500.0 // Minimum distance to move away after freezing a target
+	BotGoalRadius             = 24.0  // Bots hold position once this close to their mode goal
 	RedisKeyBotGame           = "bot:game"
 	RedisKeyBotNames          = "bot:usernames"
 	RedisKeyBotNamePool       = "bot:names"
@@ -57,6 +59,8 @@ type BotState struct {
 	DisengageUntil     time.Time
 	LastFrozenTargetID string  // ID of the last target this bot froze (avoid re-targeting)
 	DisengageTargetX   float32 // Position to move away to during disengage
+	GoalX              float32 // Mode goal the current path leads to
+	GoalY              float32
 	DisengageTargetY   float32
 }
 
@@ -65,17 +69,21 @@ type BotManager struct {
 	mu      sync.RWMutex
 	bots    map[string]*BotState
 	redis   *redis.Client
+	keys    RoomKeys
 	gsm     *GameStateManager
 	gameMap *GameMap
+	rng     *rand.Rand // The room's random source, shared with the simulation
 }
 
-// NewBotManager creates a new bot manager
-func NewBotManager(redis *redis.Client, gsm *GameStateManager, gameMap *GameMap) *BotManager {
+// NewBotManager creates a new bot manager for the room owning keys
+func NewBotManager(redis *redis.Client, gsm *GameStateManager, gameMap *GameMap, keys RoomKeys, rng *rand.Rand) *BotManager {
 	return &BotManager{
 		bots:    make(map[string]*BotState, BotCount),
 		redis:   redis,
+		keys:    keys,
 		gsm:     gsm,
 		gameMap: gameMap,
+		rng:     rng,
 	}
 }
 
@@ -88,25 +96,18 @@ func (bm *BotManager) Initialize(ctx context.Context) error {
 	logger.Debug("[BotManager] Name pool has %d names", len(namePool))
 
 	for len(bm.bots) < BotCount {
-		id := fmt.Sprintf("bot-%d-%d", len(bm.bots)+1, rand.Intn(10000))
+		id := bm.nextBotID()
 		name := bm.generateBotName(len(bm.bots)+1, namePool)
 
-		bm.bots[id] = &BotState{
-			ID:   id,
-			Name: name,
-		}
-
-		if err := bm.redis.SAdd(ctx, RedisKeyBotGame, id).Err(); err != nil {
+		if err := bm.redis.SAdd(ctx, bm.keys.BotGame, id).Err(); err != nil {
 			logger.Error("Failed to add bot to Redis: %v", err)
 		}
-		if err := bm.redis.HSet(ctx, RedisKeyBotNames, id, name).Err(); err != nil {
+		if err := bm.redis.HSet(ctx, bm.keys.BotNames, id, name).Err(); err != nil {
 			logger.Error("Failed to set bot name in Redis: %v", err)
 		}
 		logger.Info("[BotManager] Created bot: %s (%s)", name, id)
-	}
 
-	for id, bot := range bm.bots {
-		bm.gsm.AddPlayer(id, bot.Name)
+		bm.addBot(id, name)
 		logger.Info("[BotManager] Added bot %s to game state", id)
 	}
 
@@ -114,6 +115,20 @@ func (bm *BotManager) Initialize(ctx context.Context) error {
 	return nil
 }
 
+// nextBotID draws the ID for the next bot from the room's random source. Caller must hold bm.mu.
+func (bm *BotManager) nextBotID() string {
+	return fmt.Sprintf("bot-%d-%d", len(bm.bots)+1, bm.rng.Intn(10000))
+}
+
+// addBot puts a bot into the room and the game. Caller must hold bm.mu.
+func (bm *BotManager) addBot(id, name string) {
+	bm.bots[id] = &BotState{
+		ID:   id,
+		Name: name,
+	}
+	bm.gsm.addPlayer(id, name, true)
+}
+
 // generateBotName creates a bot name from the bot name pool or fallback
 func (bm *BotManager) generateBotName(index int, namePool []string) string {
 	if len(namePool) >= index && namePool[index-1] != "" {
@@ -154,9 +169,23 @@ func (bm *BotManager) IsBot(playerID string) bool {
 	return exists
 }
 
+// Reset clears every bot's targets and paths, keeping identities, used between rounds
+func (bm *BotManager) Reset() {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	for id, bot := range bm.bots {
+		bm.bots[id] = &BotState{
+			ID:   bot.ID,
+			Name: bot.Name,
+		}
+	}
+}
+
 // BotAction represents an action a bot wants to perform
 type BotAction struct {
 	BotID   string
+	Action  multiplayerv1.ActionType
 	TargetX float32
 	TargetY float32
 }
@@ -173,18 +202,19 @@ func (bm *BotManager) Update(now time.Time, players map[string]*PlayerState) []B
 	claimedTargets := make(map[string]string) // targetID -> botID that claimed it
 
 	// First pass: collect current bot targets
-	for botID, bot := range bm.bots {
+	for _, bot := range inIDOrder(bm.bots) {
 		if bot.TargetID != "" && !bot.IsRoaming {
-			claimedTargets[bot.TargetID] = botID
+			claimedTargets[bot.TargetID] = bot.ID
 		}
 	}
 
-	for botID, bot := range bm.bots {
+	for _, bot := range inIDOrder(bm.bots) {
+		botID := bot.ID
 		player, exists := players[botID]
 		if !exists {
 			continue
 		}
-		if player.IsFrozen {
+		if player.IsDisabled() {
 			player.MoveUp = false
 			player.MoveDown = false
 			player.MoveLeft = false
@@ -216,9 +246,33 @@ func (bm *BotManager) Update(now time.Time, players map[string]*PlayerState) []B
 			bot.LastRoamUpdate = now
 		}
 
+		// Urgent mode objectives, like carrying a flag home, override chasing and disengaging.
+		// The bot still throws at anyone it passes in range.
+		goal, hasGoal := bm.gsm.mode.BotGoal(bm.gsm, botID)
+		if hasGoal && goal.Urgent {
+			bot.TargetID = ""
+			bot.IsRoaming = false
+			bot.IsDisengaging = false
+			bot.SeekingAloe = false
+			bm.moveToGoal(bot, player, goal.X, goal.Y, now)
+
+			targetID, targetX, targetY, targetDist := bm.findBestTarget(botID, players, claimedTargets, bot.LastFrozenTargetID)
+			if targetID != "" && targetDist <= BotPotionRange && now.Sub(bot.LastPotionThrow) >= BotPotionCooldownMs*time.Millisecond &&
+				bm.gameMap.HasLineOfSight(player.X, player.Y, targetX, targetY) {
+				actions = append(actions, BotAction{
+					BotID:   botID,
+					Action:  multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION,
+					TargetX: targetX,
+					TargetY: targetY,
+				})
+				bot.LastPotionThrow = now
+			}
+			continue
+		}
+
 		// If current target is frozen, enter disengage mode
 		if bot.TargetID != "" {
-			if targetPlayer, exists := players[bot.TargetID]; exists && targetPlayer.IsFrozen {
+			if targetPlayer, exists := players[bot.TargetID]; exists && targetPlayer.IsDisabled() {
 				frozenTargetID := bot.TargetID
 				bot.LastFrozenTargetID = frozenTargetID
 				bot.TargetID = ""
@@ -297,10 +351,12 @@ func (bm *BotManager) Update(now time.Time, players map[string]*PlayerState) []B
 				bm.moveDirectlyToward(player, targetX, targetY)
 			}
 
-			// Queue potion throw if in range (will be executed after mutex released)
-			if targetDist <= BotPotionRange && now.Sub(bot.LastPotionThrow) >= BotPotionCooldownMs*time.Millisecond {
+			// Queue potion throw if in range and not into a wall (will be executed after mutex released)
+			if targetDist <= BotPotionRange && now.Sub(bot.LastPotionThrow) >= BotPotionCooldownMs*time.Millisecond &&
+				bm.gameMap.HasLineOfSight(player.X, player.Y, targetX, targetY) {
 				actions = append(actions, BotAction{
 					BotID:   botID,
+					Action:  multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION,
 					TargetX: targetX,
 					TargetY: targetY,
 				})
@@ -311,9 +367,17 @@ func (bm *BotManager) Update(now time.Time, players map[string]*PlayerState) []B
 			bot.TargetID = ""
 			bot.IsRoaming = true
 
+			// Game mode objectives come before skirmishes and aloe
+			if hasGoal {
+				bot.SeekingAloe = false
+				bot.AloeTargetID = ""
+				bm.moveToGoal(bot, player, goal.X, goal.Y, now)
+				continue
+			}
+
 			// Occasionally decide to target another bot for a skirmish (makes map feel lively)
 			// But skip if we're still in cooldown from recently freezing someone
-			skirmishRoll := rand.Float32()
+			skirmishRoll := bm.rng.Float32()
 			if skirmishRoll < BotSkirmishChance && bot.LastFrozenTargetID == "" {
 				// Try nearby skirmish first
 				skirmishTarget, sx, sy, sDist := bm.findNearestBot(botID, players, bot.LastFrozenTargetID)
@@ -400,7 +464,7 @@ func (bm *BotManager) Update(now time.Time, players map[string]*PlayerState) []B
 
 // pickRoamTarget selects a random passable location for the bot to roam to
 func (bm *BotManager) pickRoamTarget(bot *BotState) {
-	tileX, tileY, ok := bm.gameMap.RandomPassableTile()
+	tileX, tileY, ok := bm.gameMap.RandomPassableTile(bm.rng)
 	if ok {
 		bot.RoamTargetX = float32(tileX*bm.gameMap.TileSize) + float32(bm.gameMap.TileSize)/2
 		bot.RoamTargetY = float32(tileY*bm.gameMap.TileSize) + float32(bm.gameMap.TileSize)/2
@@ -414,7 +478,7 @@ func (bm *BotManager) pickSmartRoamTarget(bot *BotState, botID string, players m
 	bestMinDist := float32(0)
 
 	for range 10 {
-		tileX, tileY, ok := bm.gameMap.RandomPassableTile()
+		tileX, tileY, ok := bm.gameMap.RandomPassableTile(bm.rng)
 		if !ok {
 			continue
 		}
@@ -473,7 +537,7 @@ func (bm *BotManager) pickDisengageTarget(bot *BotState, botID string, players m
 	bestScore := float32(-math.MaxFloat32)
 
 	for range 15 {
-		tileX, tileY, ok := bm.gameMap.RandomPassableTile()
+		tileX, tileY, ok := bm.gameMap.RandomPassableTile(bm.rng)
 		if !ok {
 			continue
 		}
@@ -543,7 +607,7 @@ func (bm *BotManager) findNearestAloe(botX, botY float32) (float32, float32, str
 	nearestDist := float32(math.MaxFloat32)
 
 	for _, item := range items {
-		if item.Position == nil {
+		if item.Position == nil || item.Type != multiplayerv1.ItemType_ITEM_TYPE_ALOE {
 			continue
 		}
 		dist := distance(botX, botY, item.Position.X, item.Position.Y)
@@ -571,7 +635,8 @@ func (bm *BotManager) findNearestBot(botID string, players map[string]*PlayerSta
 	var nearestID string
 	var nearestDist float32 = math.MaxFloat32
 
-	for otherID := range bm.bots {
+	for _, other := range inIDOrder(bm.bots) {
+		otherID := other.ID
 		if otherID == botID {
 			continue
 		}
@@ -580,8 +645,8 @@ func (bm *BotManager) findNearestBot(botID string, players map[string]*PlayerSta
 			continue
 		}
 		if otherPlayer, exists := players[otherID]; exists {
-			// Skip frozen bots
-			if otherPlayer.IsFrozen {
+			// Skip frozen bots and teammates
+			if otherPlayer.IsDisabled() || teammates(botPlayer, otherPlayer) {
 				continue
 			}
 			dist := distance(botPlayer.X, botPlayer.Y, otherPlayer.X, otherPlayer.Y)
@@ -609,7 +674,8 @@ func (bm *BotManager) findFarthestBot(botID string, players map[string]*PlayerSt
 	var farthestID string
 	var farthestDist float32 = 0
 
-	for otherID := range bm.bots {
+	for _, other := range inIDOrder(bm.bots) {
+		otherID := other.ID
 		if otherID == botID {
 			continue
 		}
@@ -618,8 +684,8 @@ func (bm *BotManager) findFarthestBot(botID string, players map[string]*PlayerSt
 			continue
 		}
 		if otherPlayer, exists := players[otherID]; exists {
-			// Skip frozen bots
-			if otherPlayer.IsFrozen {
+			// Skip frozen bots and teammates
+			if otherPlayer.IsDisabled() || teammates(botPlayer, otherPlayer) {
 				continue
 			}
 			dist := distance(botPlayer.X, botPlayer.Y, otherPlayer.X, otherPlayer.Y)
@@ -679,7 +745,8 @@ func (bm *BotManager) findBestTarget(botID string, players map[string]*PlayerSta
 
 	var candidates []candidate
 
-	for id, p := range players {
+	for _, p := range inIDOrder(players) {
+		id := p.UserID
 		if id == botID {
 			continue
 		}
@@ -689,8 +756,8 @@ func (bm *BotManager) findBestTarget(botID string, players map[string]*PlayerSta
 			continue
 		}
 
-		// Skip frozen players - no point chasing them
-		if p.IsFrozen {
+		// Skip frozen players - no point chasing them - ones bots cannot see and teammates
+		if p.IsDisabled() || p.HasStatus(StatusInvisible) || teammates(botPlayer, p) {
 			continue
 		}
 
@@ -814,6 +881,32 @@ func (bm *BotManager) followPath(bot *BotState, player *PlayerState) bool {
 	return player.MoveUp || player.MoveDown || player.MoveLeft || player.MoveRight
 }
 
+// moveToGoal paths a bot toward its mode goal and holds position once there
+func (bm *BotManager) moveToGoal(bot *BotState, player *PlayerState, goalX, goalY float32, now time.Time) {
+	if distance(player.X, player.Y, goalX, goalY) <= BotGoalRadius {
+		player.MoveUp = false
+		player.MoveDown = false
+		player.MoveLeft = false
+		player.MoveRight = false
+		return
+	}
+
+	goalMoved := distance(bot.GoalX, bot.GoalY, goalX, goalY) > float32(bm.gameMap.TileSize)
+	needsNewPath := now.Sub(bot.LastPathUpdate) >= BotPathUpdateMs*time.Millisecond
+	pathExhausted := len(bot.Path) == 0 || bot.PathIndex >= len(bot.Path)
+	if goalMoved || needsNewPath || pathExhausted {
+		bot.GoalX, bot.GoalY = goalX, goalY
+		bot.RoamTargetX, bot.RoamTargetY = goalX, goalY
+		bot.Path = bm.computePath(player.X, player.Y, goalX, goalY)
+		bot.PathIndex = 0
+		bot.LastPathUpdate = now
+	}
+
+	if !bm.followPath(bot, player) {
+		bm.moveDirectlyToward(player, goalX, goalY)
+	}
+}
+
 // moveDirectlyToward sets movement inputs to move directly toward a target (fallback when no path)
 func (bm *BotManager) moveDirectlyToward(player *PlayerState, targetX, targetY float32) bool {
 	dx := targetX - player.X
@@ -1045,8 +1138,8 @@ func clampInt(value, min, max int) int {
 	return value
 }
 
-func actualPlayerCount(ctx context.Context, redisClient *redis.Client) (int, error) {
-	ids, err := redisClient.SUnion(ctx, RedisKeyLobbyUsers, RedisKeyGameUsers).Result()
+func actualPlayerCount(ctx context.Context, redisClient *redis.Client, keys RoomKeys) (int, error) {
+	ids, err := redisClient.SUnion(ctx, keys.LobbyUsers, keys.GameUsers).Result()
 	if err != nil {
 		return 0, err
 	}
@@ -1058,7 +1151,7 @@ func (bm *BotManager) Cleanup(ctx context.Context) {
 	bm.mu.Lock()
 	defer bm.mu.Unlock()
 
-	actualCount, err := actualPlayerCount(ctx, bm.redis)
+	actualCount, err := actualPlayerCount(ctx, bm.redis, bm.keys)
 	if err != nil {
 		logger.Error("[BotManager] Failed to count actual players for cleanup: %v", err)
 		return
@@ -1069,8 +1162,8 @@ func (bm *BotManager) Cleanup(ctx context.Context) {
 	}
 
 	for id := range bm.bots {
-		bm.redis.SRem(ctx, RedisKeyBotGame, id)
-		bm.redis.HDel(ctx, RedisKeyBotNames, id)
+		bm.redis.SRem(ctx, bm.keys.BotGame, id)
+		bm.redis.HDel(ctx, bm.keys.BotNames, id)
 	}
 	bm.bots = make(map[string]*BotState, BotCount)
 }
@@ -24,6 +24,12 @@ const (
 
 	// Maximum message size allowed from peer.
 	maxMessageSize = 1000
+
+	// Messages buffered for a peer before it is evicted as too slow.
+	sendQueueSize = 64
+
+	// Game state snapshots a peer may fall behind in a row (~1s) before it is evicted.
+	maxStaleSnapshots = 33
 )
 
 type Client struct {
@@ -34,12 +40,34 @@ type Client struct {
 	conn     *websocket.Conn
 
 	hub   *Hub
+	room  *Room
 	token string
 
+	// resumed is set when the client reattached to a player held after a disconnect
+	resumed bool
+
+	// spectator clients watch the room without ever joining the game
+	spectator bool
+	// following is the player a spectator watches, empty for an overview of the map
+	following string
+
 	sendChan chan []byte
+
+	// Only the newest game state is kept, older unsent snapshots are replaced
+	stateMu      sync.Mutex
+	pendingState []byte
+	staleStates  int
+	stateReady   chan struct{}
+	snapshots    snapshotHistory
+
+	// done is closed once the client is shutting down; sendChan itself is never closed
+	done      chan struct{}
+	closeOnce sync.Once
+	// closeMessage is the close frame sent on shutdown, set before done is closed
+	closeMessage []byte
 }
 
-func NewClient(hub *Hub, conn *websocket.Conn, token string) error {
+func NewClient(hub *Hub, conn *websocket.Conn, token string, roomID string, spectator bool) error {
 	// Require a valid token for WebSocket connections
 	if token == "" {
 		conn.WriteMessage(websocket.CloseMessage,
@@ -57,13 +85,36 @@ func NewClient(hub *Hub, conn *websocket.Conn, token string) error {
 		return fmt.Errorf("invalid or expired session: %w", err)
 	}
 
+	// A dropped player reconnecting in time goes back to the room holding their state,
+	// spectators have no player to resume
+	var room *Room
+	resumed := false
+	if !spectator {
+		room, resumed = hub.resumeSession(token)
+	}
+	if !resumed {
+		room, err = hub.acquireRoom(roomID)
+		if err != nil {
+			logger.Warn("Failed to join room %q: %v", roomID, err)
+			conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "Unable to join room"))
+			conn.Close()
+			return fmt.Errorf("unable to join room: %w", err)
+		}
+	}
+
 	client := &Client{
-		UserID:   sessionInfo.UserID,
-		Username: sessionInfo.Username,
-		hub:      hub,
-		conn:     conn,
-		token:    token,
-		sendChan: make(chan []byte),
+		UserID:     sessionInfo.UserID,
+		Username:   sessionInfo.Username,
+		hub:        hub,
+		room:       room,
+		conn:       conn,
+		token:      token,
+		resumed:    resumed,
+		spectator:  spectator,
+		sendChan:   make(chan []byte, sendQueueSize),
+		stateReady: make(chan struct{}, 1),
+		done:       make(chan struct{}),
 	}
 
 	hub.register <- client
@@ -84,7 +135,7 @@ func (client *Client) GetName() string {
 
 func (client *Client) readPump() {
 	defer func() {
-		close(client.sendChan)
+		client.close()
 		client.hub.unregister <- client
 		client.conn.Close()
 	}()
@@ -109,6 +160,7 @@ func (client *Client) readPump() {
 
 	for {
 		_, message, err := client.conn.ReadMessage()
+		receivedAt := time.Now()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err,
 				websocket.CloseGoingAway,
@@ -116,77 +168,227 @@ func (client *Client) readPump() {
 				websocket.CloseNormalClosure) {
 				logger.Error("WebSocket error: %v", err)
 			}
+			// The hub decides on unregister whether to hold the player for a resume or remove them
 			logger.Info("%s (%s) disconnected", client.Username, client.UserID)
-
-			// Handle player leaving - notify game state using their UserID
-			client.hub.gameStateManager.RemovePlayer(client.UserID)
 			break
 		}
 
-		// Inject sender info into chat messages before publishing
-		message = client.injectSenderInfo(message)
+		gameMsg, err := decodeClientMessage(message)
+		if err != nil {
+			logger.Warn("Dropping message from %s (%s): %v", client.Username, client.UserID, err)
+			continue
+		}
+
+		if timeSync := gameMsg.GetTimeSync(); timeSync != nil {
+			client.replyTimeSync(timeSync, receivedAt)
+			continue
+		}
 
-		client.hub.pubsub.conn.Publish(context.Background(), "chat.lobby", message)
+		if ack := gameMsg.GetSnapshotAck(); ack != nil {
+			client.snapshots.ack(ack.SnapshotId)
+			continue
+		}
+
+		if follow := gameMsg.GetSpectatorFollow(); follow != nil {
+			if client.spectator {
+				client.follow(follow.GetPlayerId().GetValue())
+			}
+			continue
+		}
+
+		// Spectators only watch and chat, they never join or control a player
+		if client.spectator && gameMsg.GetPlayerEvent() != nil {
+			logger.Warn("Dropping %v event from spectator %s (%s)", gameMsg.GetPlayerEvent().Type, client.Username, client.UserID)
+			continue
+		}
+
+		// Player events go straight to the room so a JOIN lands before the inputs after
+		// it, Redis only carries traffic other processes care about such as chat
+		if event := gameMsg.GetPlayerEvent(); event != nil {
+			client.room.handlePlayerEvent(client.UserID, event)
+			continue
+		}
+
+		envelope, err := client.wrapMessage(gameMsg)
+		if err != nil {
+			logger.Warn("Dropping message from %s (%s): %v", client.Username, client.UserID, err)
+			continue
+		}
+
+		client.hub.pubsub.conn.Publish(context.Background(), client.room.channel(SpaceLobby), envelope)
 	}
 }
 
-// injectSenderInfo adds sender ID and username to chat messages
-func (client *Client) injectSenderInfo(message []byte) []byte {
+// decodeClientMessage parses a client message and rejects server-only message types
+func decodeClientMessage(message []byte) (*multiplayerv1.GameMessage, error) {
 	gameMsg := &multiplayerv1.GameMessage{}
 	if err := proto.Unmarshal(message, gameMsg); err != nil {
-		return message
+		return nil, fmt.Errorf("malformed message: %w", err)
+	}
+
+	// Clients may only send chat, player events, acks, time syncs and spectate requests,
+	// everything else is server-originated
+	switch gameMsg.Type {
+	case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE,
+		multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+		multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_SNAPSHOT_ACK,
+		multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_TIME_SYNC,
+		multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_SPECTATE:
+	default:
+		return nil, fmt.Errorf("message type %v not allowed from clients", gameMsg.Type)
 	}
 
-	// Only inject sender info for chat messages
-	if gameMsg.Type == multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE {
-		if chatMsg := gameMsg.GetChatMessage(); chatMsg != nil {
-			// Set sender ID and name from client info
-			chatMsg.SenderId = &multiplayerv1.ID{Value: client.UserID}
-			chatMsg.SenderName = client.Username
+	return gameMsg, nil
+}
 
-			// Re-marshal the modified message
-			modified, err := proto.Marshal(gameMsg)
-			if err != nil {
-				logger.Error("Failed to marshal modified chat message: %v", err)
-				return message
-			}
-			return modified
-		}
+// wrapMessage wraps a client message in an Envelope stamped with the
+// authenticated sender, so handlers never trust IDs from the payload
+func (client *Client) wrapMessage(gameMsg *multiplayerv1.GameMessage) ([]byte, error) {
+	envelope := &multiplayerv1.Envelope{
+		SenderId:   &multiplayerv1.ID{Value: client.UserID},
+		SenderName: client.Username,
+		Message:    gameMsg,
+	}
+
+	wire, err := proto.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal envelope: %w", err)
+	}
+	return wire, nil
+}
+
+// queue buffers a message for the client without blocking. A client whose
+// queue is full has fallen too far behind and is evicted.
+func (client *Client) queue(message []byte) bool {
+	select {
+	case <-client.done:
+		return false
+	default:
 	}
 
+	select {
+	case client.sendChan <- message:
+		return true
+	default:
+		client.evict("send queue full")
+		return false
+	}
+}
+
+// queueGameState replaces any snapshot the client has not been sent yet with the latest one
+func (client *Client) queueGameState(message []byte) {
+	client.stateMu.Lock()
+	if client.pendingState != nil {
+		client.staleStates++
+	}
+	client.pendingState = message
+	stale := client.staleStates
+	client.stateMu.Unlock()
+
+	if stale > maxStaleSnapshots {
+		client.evict("too far behind on game state")
+		return
+	}
+
+	select {
+	case client.stateReady <- struct{}{}:
+	default:
+	}
+}
+
+// replyTimeSync echoes a time sync request with the server's receive and send times
+func (client *Client) replyTimeSync(request *multiplayerv1.TimeSync, receivedAt time.Time) {
+	reply := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_TIME_SYNC,
+		Payload: &multiplayerv1.GameMessage_TimeSync{
+			TimeSync: &multiplayerv1.TimeSync{
+				ClientSendMs:    request.ClientSendMs,
+				ServerReceiveMs: receivedAt.UnixMilli(),
+				ServerSendMs:    time.Now().UnixMilli(),
+			},
+		},
+	}
+
+	wire, err := proto.Marshal(reply)
+	if err != nil {
+		logger.Error("Failed to marshal time sync: %v", err)
+		return
+	}
+	client.queue(wire)
+}
+
+// queueSnapshot encodes a snapshot against the client's acked baseline and queues it
+func (client *Client) queueSnapshot(snap *snapshot) {
+	wire, err := snap.encode(client.snapshots.baseline())
+	if err != nil {
+		logger.Error("Failed to marshal game state: %v", err)
+		return
+	}
+
+	client.snapshots.record(snap)
+	client.queueGameState(wire)
+}
+
+// takeGameState returns the pending snapshot, if any, and clears it
+func (client *Client) takeGameState() []byte {
+	client.stateMu.Lock()
+	defer client.stateMu.Unlock()
+
+	message := client.pendingState
+	client.pendingState = nil
+	client.staleStates = 0
 	return message
 }
 
+// evict disconnects a client that cannot keep up with the room
+func (client *Client) evict(reason string) {
+	client.closeOnce.Do(func() {
+		logger.Warn("Evicting %s (%s): %s", client.Username, client.UserID, reason)
+		close(client.done)
+	})
+}
+
+// reject disconnects a client the room will not take, telling it why
+func (client *Client) reject(reason string) {
+	client.closeOnce.Do(func() {
+		logger.Warn("Rejecting %s (%s) from room %s: %s", client.Username, client.UserID, client.room.ID, reason)
+		client.closeMessage = websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason)
+		close(client.done)
+	})
+}
+
+// close signals the write pump to stop; safe to call more than once
+func (client *Client) close() {
+	client.closeOnce.Do(func() {
+		close(client.done)
+	})
+}
+
 func (client *Client) writePump() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
 		ticker.Stop()
+		client.close()
 		client.conn.Close()
 	}()
 
 	for {
 		select {
-		case msg, ok := <-client.sendChan:
+		case <-client.done:
 			client.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				// The hub closed the channel.
-				client.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
+			client.conn.WriteMessage(websocket.CloseMessage, client.closeMessage)
+			return
 
-			w, err := client.conn.NextWriter(websocket.BinaryMessage)
-			if err != nil {
+		case msg := <-client.sendChan:
+			if err := client.write(msg); err != nil {
 				return
 			}
 
-			w.Write(msg)
-
-			for range len(client.sendChan) {
-				w.Write(<-client.sendChan)
-			}
-
-			if err := w.Close(); err != nil {
-				return
+		case <-client.stateReady:
+			if msg := client.takeGameState(); msg != nil {
+				if err := client.write(msg); err != nil {
+					return
+				}
 			}
 
 		case <-ticker.C:
@@ -197,3 +399,9 @@ func (client *Client) writePump() {
 		}
 	}
 }
+
+// write sends a single protobuf message as its own binary frame
+func (client *Client) write(msg []byte) error {
+	client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return client.conn.WriteMessage(websocket.BinaryMessage, msg)
+}
@@ -0,0 +1,289 @@
+package hub
+
+import (
+	"fmt"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	CTFTeams                   = 2
+	CTFCaptureLimit            = 3
+	CTFFlagTouchRadius float32 = 24
+	CTFFlagReturnTime          = 15 * time.Second // A dropped flag goes home on its own after this
+	CTFCarrierSpeed    float32 = 0.75
+	ScorePerCapture            = 10
+)
+
+type FlagStatus int
+
+const (
+	FlagAtBase FlagStatus = iota
+	FlagCarried
+	FlagDropped
+)
+
+// Flag is a team's flag in capture the flag
+type Flag struct {
+	Team      int
+	Status    FlagStatus
+	X, Y      float32
+	Base      MapPoint
+	CarrierID string
+	ReturnsAt time.Time
+}
+
+type ctfRole int
+
+const (
+	ctfAttacker ctfRole = iota
+	ctfDefender
+)
+
+// CaptureTheFlag gives each team a flag at a base position. Players grab the enemy
+// flag and bring it to their own base while their flag is home. Carriers are slowed
+// and drop the flag when frozen or eliminated. First team to CTFCaptureLimit wins.
+type CaptureTheFlag struct {
+	flags    []*Flag // Indexed by team - 1
+	captures []int   // Indexed by team
+	roles    map[string]ctfRole
+}
+
+func NewCaptureTheFlag() *CaptureTheFlag {
+	return &CaptureTheFlag{
+		captures: make([]int, CTFTeams+1),
+		roles:    make(map[string]ctfRole),
+	}
+}
+
+func (*CaptureTheFlag) Type() multiplayerv1.GameMode {
+	return multiplayerv1.GameMode_GAME_MODE_CAPTURE_THE_FLAG
+}
+
+func (*CaptureTheFlag) Teams() int      { return CTFTeams }
+func (*CaptureTheFlag) ScoreLimit() int { return 0 }
+func (*CaptureTheFlag) Respawns() bool  { return true }
+
+func (*CaptureTheFlag) FreezeDuration() time.Duration {
+	return statusEffects[StatusFrozen].Duration
+}
+
+func (ctf *CaptureTheFlag) StartRound(gsm *GameStateManager, now time.Time) {
+	ctf.captures = make([]int, CTFTeams+1)
+	gsm.balanceTeams()
+	ctf.placeFlags(gsm)
+}
+
+// placeFlags puts every flag at its base. Bases come from "flag_<team>" map markers,
+// otherwise from opposite sides of the map.
+func (ctf *CaptureTheFlag) placeFlags(gsm *GameStateManager) {
+	ctf.flags = make([]*Flag, CTFTeams)
+	for i := range ctf.flags {
+		team := i + 1
+		base, ok := gsm.gameMap.Marker(fmt.Sprintf("flag_%d", team))
+		if !ok {
+			// Spread bases evenly across the map's width at mid height
+			x := gsm.gameMap.PixelWidth * (float32(i) + 0.5) / CTFTeams
+			base, _ = gsm.gameMap.NearestPassablePoint(x, gsm.gameMap.PixelHeight/2)
+		}
+		ctf.flags[i] = &Flag{Team: team, Base: base}
+		ctf.returnFlag(gsm, ctf.flags[i])
+	}
+}
+
+func (ctf *CaptureTheFlag) Update(gsm *GameStateManager, now time.Time) (int, bool) {
+	if ctf.flags == nil {
+		ctf.placeFlags(gsm)
+	}
+
+	for _, flag := range ctf.flags {
+		switch flag.Status {
+		case FlagCarried:
+			ctf.updateCarriedFlag(gsm, flag, now)
+		case FlagDropped:
+			if !now.Before(flag.ReturnsAt) {
+				ctf.returnFlag(gsm, flag)
+				continue
+			}
+			ctf.touchFlag(gsm, flag, now)
+		case FlagAtBase:
+			ctf.touchFlag(gsm, flag, now)
+		}
+	}
+
+	for team := 1; team <= CTFTeams; team++ {
+		if ctf.captures[team] >= CTFCaptureLimit {
+			return team, true
+		}
+	}
+	return 0, false
+}
+
+// updateCarriedFlag moves a flag with its carrier, dropping it if the carrier is out of
+// play and scoring it if they reached their base while their own flag is home
+func (ctf *CaptureTheFlag) updateCarriedFlag(gsm *GameStateManager, flag *Flag, now time.Time) {
+	carrier, exists := gsm.players[flag.CarrierID]
+	if !exists || carrier.IsDisabled() {
+		ctf.dropFlag(gsm, flag, carrier, now)
+		return
+	}
+	flag.X, flag.Y = carrier.X, carrier.Y
+
+	home := ctf.flags[carrier.Team-1]
+	if home.Status != FlagAtBase || !withinFlagTouch(carrier, home.Base) {
+		return
+	}
+
+	// Flags can be run home before the round starts, but only count while it is live
+	if !gsm.matchManager.IsLive() {
+		ctf.returnFlag(gsm, flag)
+		return
+	}
+
+	ctf.captures[carrier.Team]++
+	gsm.matchManager.RecordCapture(carrier.UserID)
+	logger.Info("[CTF] Player %s captured team %d's flag (%d/%d)",
+		carrier.UserID, flag.Team, ctf.captures[carrier.Team], CTFCaptureLimit)
+	ctf.returnFlag(gsm, flag)
+}
+
+// touchFlag lets an opponent pick up a flag on the ground, or a teammate return it
+func (ctf *CaptureTheFlag) touchFlag(gsm *GameStateManager, flag *Flag, now time.Time) {
+	for _, player := range inIDOrder(gsm.players) {
+		if player.Team == 0 || player.IsDisabled() || !withinFlagTouch(player, MapPoint{X: flag.X, Y: flag.Y}) {
+			continue
+		}
+
+		if player.Team == flag.Team {
+			if flag.Status == FlagDropped {
+				logger.Debug("[CTF] Player %s returned team %d's flag", player.UserID, flag.Team)
+				ctf.returnFlag(gsm, flag)
+				return
+			}
+			continue
+		}
+
+		if gsm.applyStatus(player, StatusCarryingFlag, now, "") {
+			flag.Status = FlagCarried
+			flag.CarrierID = player.UserID
+			flag.ReturnsAt = time.Time{}
+			logger.Debug("[CTF] Player %s picked up team %d's flag", player.UserID, flag.Team)
+			return
+		}
+	}
+}
+
+// dropFlag leaves a carried flag where its carrier was
+func (ctf *CaptureTheFlag) dropFlag(gsm *GameStateManager, flag *Flag, carrier *PlayerState, now time.Time) {
+	if carrier != nil {
+		flag.X, flag.Y = carrier.X, carrier.Y
+		carrier.RemoveStatus(StatusCarryingFlag)
+	}
+	flag.Status = FlagDropped
+	flag.CarrierID = ""
+	flag.ReturnsAt = now.Add(CTFFlagReturnTime)
+	logger.Debug("[CTF] Team %d's flag dropped at (%.1f, %.1f)", flag.Team, flag.X, flag.Y)
+}
+
+// returnFlag sends a flag back to its base, freeing any carrier
+func (ctf *CaptureTheFlag) returnFlag(gsm *GameStateManager, flag *Flag) {
+	if carrier, exists := gsm.players[flag.CarrierID]; exists {
+		carrier.RemoveStatus(StatusCarryingFlag)
+	}
+	flag.Status = FlagAtBase
+	flag.X, flag.Y = flag.Base.X, flag.Base.Y
+	flag.CarrierID = ""
+	flag.ReturnsAt = time.Time{}
+}
+
+func withinFlagTouch(player *PlayerState, point MapPoint) bool {
+	dx := player.X - point.X
+	dy := player.Y - point.Y
+	return dx*dx+dy*dy <= CTFFlagTouchRadius*CTFFlagTouchRadius
+}
+
+func (ctf *CaptureTheFlag) Reset(gsm *GameStateManager) {
+	ctf.flags = nil
+	ctf.captures = make([]int, CTFTeams+1)
+	ctf.roles = make(map[string]ctfRole)
+}
+
+// BotGoal has carriers run home and bots recover their own flag, then splits the
+// rest into one defender per team guarding the base and attackers going for the enemy flag
+func (ctf *CaptureTheFlag) BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool) {
+	bot, exists := gsm.players[botID]
+	if !exists || bot.Team <= 0 || bot.Team > CTFTeams || ctf.flags == nil {
+		return BotGoal{}, false
+	}
+	own := ctf.flags[bot.Team-1]
+	enemy := ctf.flags[CTFTeams-bot.Team]
+
+	if enemy.CarrierID == botID {
+		return BotGoal{X: own.Base.X, Y: own.Base.Y, Urgent: true}, true
+	}
+	switch own.Status {
+	case FlagDropped:
+		return BotGoal{X: own.X, Y: own.Y, Urgent: true}, true
+	case FlagCarried:
+		return BotGoal{X: own.X, Y: own.Y}, true
+	}
+
+	if ctf.role(gsm, bot) == ctfDefender {
+		return BotGoal{X: own.Base.X, Y: own.Base.Y}, true
+	}
+	// Attackers go for the enemy flag, or escort the teammate carrying it
+	return BotGoal{X: enemy.X, Y: enemy.Y}, true
+}
+
+// role assigns a bot its job the first time it is asked, one defender per team
+func (ctf *CaptureTheFlag) role(gsm *GameStateManager, bot *PlayerState) ctfRole {
+	if role, ok := ctf.roles[bot.UserID]; ok {
+		return role
+	}
+
+	role := ctfDefender
+	for id, assigned := range ctf.roles {
+		if other, exists := gsm.players[id]; exists && assigned == ctfDefender && teammates(other, bot) {
+			role = ctfAttacker
+			break
+		}
+	}
+	ctf.roles[bot.UserID] = role
+	return role
+}
+
+func (ctf *CaptureTheFlag) Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time) {
+	for _, team := range state.Teams {
+		if int(team.Team) < len(ctf.captures) {
+			team.Captures = int32(ctf.captures[team.Team])
+		}
+	}
+
+	for _, flag := range ctf.flags {
+		flagState := &multiplayerv1.FlagState{
+			Team:        int32(flag.Team),
+			Status:      flag.protoStatus(),
+			Position:    &multiplayerv1.Vector2{X: flag.X, Y: flag.Y},
+			Base:        &multiplayerv1.Vector2{X: flag.Base.X, Y: flag.Base.Y},
+			ReturnsAtMs: unixMillis(flag.ReturnsAt),
+		}
+		if flag.CarrierID != "" {
+			flagState.CarrierId = &multiplayerv1.ID{Value: flag.CarrierID}
+		}
+		state.Flags = append(state.Flags, flagState)
+	}
+}
+
+func (flag *Flag) protoStatus() multiplayerv1.FlagStatus {
+	switch flag.Status {
+	case FlagAtBase:
+		return multiplayerv1.FlagStatus_FLAG_STATUS_AT_BASE
+	case FlagCarried:
+		return multiplayerv1.FlagStatus_FLAG_STATUS_CARRIED
+	case FlagDropped:
+		return multiplayerv1.FlagStatus_FLAG_STATUS_DROPPED
+	}
+	return multiplayerv1.FlagStatus_FLAG_STATUS_UNSPECIFIED
+}
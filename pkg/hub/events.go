@@ -0,0 +1,249 @@
+package hub
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	QuicksandEventInterval  = 30 * time.Second
+	QuicksandEventDuration  = 20 * time.Second
+	QuicksandEventTileCount = 100
+	QuicksandEventTileID    = 237
+
+	SandstormVisionScale float32 = 0.5
+
+	MeteorInterval             = 1500 * time.Millisecond // Time between meteors during a shower
+	MeteorWarning              = 1500 * time.Millisecond // Time from a meteor appearing to its impact
+	MeteorZoneDuration         = 3 * time.Second         // Time a landed meteor keeps freezing
+	MeteorRadius       float32 = 64
+	MeteorScatter      float32 = 240 // Meteors land within this distance of a random player
+
+	AloeBloomCount          = 12
+	AloeBloomRadius float32 = 120
+)
+
+// Quicksand turns random passable tiles into slowdown tiles
+type Quicksand struct {
+	tiles map[int]struct{} // Keyed by tileY*width + tileX
+}
+
+func (*Quicksand) Kind() multiplayerv1.WorldEventKind {
+	return multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_QUICKSAND
+}
+
+func (q *Quicksand) Start(gsm *GameStateManager, now time.Time) bool {
+	q.tiles = make(map[int]struct{})
+	maxAttempts := QuicksandEventTileCount * 10
+	for attempts := 0; len(q.tiles) < QuicksandEventTileCount && attempts < maxAttempts; attempts++ {
+		tileX, tileY, ok := gsm.gameMap.RandomPassableTile(gsm.rng)
+		if !ok {
+			break
+		}
+		q.tiles[tileY*gsm.gameMap.Width+tileX] = struct{}{}
+	}
+
+	logger.Debug("[Quicksand] New event started with %d tiles", len(q.tiles))
+	return len(q.tiles) > 0
+}
+
+func (*Quicksand) Update(gsm *GameStateManager, now time.Time) {}
+
+func (q *Quicksand) End(gsm *GameStateManager, now time.Time) {
+	logger.Debug("[Quicksand] Event expired, clearing %d tiles", len(q.tiles))
+	q.tiles = nil
+}
+
+// SlowsAt reports whether a position is on a quicksand tile
+func (q *Quicksand) SlowsAt(gameMap *GameMap, x, y float32) bool {
+	tileX := int(x) / gameMap.TileSize
+	tileY := int(y) / gameMap.TileSize
+	if tileX < 0 || tileX >= gameMap.Width || tileY < 0 || tileY >= gameMap.Height {
+		return false
+	}
+
+	_, exists := q.tiles[tileY*gameMap.Width+tileX]
+	return exists
+}
+
+// tileCoords lists the tiles sorted so an unchanged event encodes identically across snapshots
+func (q *Quicksand) tileCoords(gameMap *GameMap) []*multiplayerv1.TileCoord {
+	tiles := make([]*multiplayerv1.TileCoord, 0, len(q.tiles))
+	for _, key := range slices.Sorted(maps.Keys(q.tiles)) {
+		tiles = append(tiles, &multiplayerv1.TileCoord{
+			X: int32(key % gameMap.Width),
+			Y: int32(key / gameMap.Width),
+		})
+	}
+	return tiles
+}
+
+func (q *Quicksand) Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent) {
+	state.Tiles = q.tileCoords(gsm.gameMap)
+	state.TileId = QuicksandEventTileID
+}
+
+// Sandstorm shrinks every player's view radius while it lasts
+type Sandstorm struct{}
+
+func (*Sandstorm) Kind() multiplayerv1.WorldEventKind {
+	return multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_SANDSTORM
+}
+
+func (*Sandstorm) Start(gsm *GameStateManager, now time.Time) bool { return true }
+func (*Sandstorm) Update(gsm *GameStateManager, now time.Time)     {}
+func (*Sandstorm) End(gsm *GameStateManager, now time.Time)        {}
+func (*Sandstorm) VisionScale() float32                            { return SandstormVisionScale }
+
+func (*Sandstorm) Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent) {
+	state.VisionScale = SandstormVisionScale
+}
+
+// Meteor is one impact of a meteor shower
+type Meteor struct {
+	X, Y     float32
+	ImpactAt time.Time
+	FadesAt  time.Time
+}
+
+// MeteorShower drops meteors near random players. Each lands after a warning and
+// freezes anyone inside its radius until it fades.
+type MeteorShower struct {
+	meteors      []*Meteor
+	nextMeteorAt time.Time
+}
+
+func (*MeteorShower) Kind() multiplayerv1.WorldEventKind {
+	return multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_METEOR_SHOWER
+}
+
+func (ms *MeteorShower) Start(gsm *GameStateManager, now time.Time) bool {
+	ms.meteors = nil
+	ms.nextMeteorAt = now
+	return true
+}
+
+func (ms *MeteorShower) Update(gsm *GameStateManager, now time.Time) {
+	ms.meteors = slices.DeleteFunc(ms.meteors, func(meteor *Meteor) bool {
+		return !now.Before(meteor.FadesAt)
+	})
+
+	if !now.Before(ms.nextMeteorAt) {
+		ms.nextMeteorAt = now.Add(MeteorInterval)
+		if meteor, ok := ms.dropMeteor(gsm, now); ok {
+			ms.meteors = append(ms.meteors, meteor)
+		}
+	}
+
+	radiusSq := MeteorRadius * MeteorRadius
+	for _, meteor := range ms.meteors {
+		if now.Before(meteor.ImpactAt) {
+			continue
+		}
+		for _, player := range inIDOrder(gsm.players) {
+			if player.Eliminated {
+				continue
+			}
+			dx := player.X - meteor.X
+			dy := player.Y - meteor.Y
+			if dx*dx+dy*dy <= radiusSq && gsm.freezePlayer(player, now, "") {
+				logger.Debug("[Meteor] Player %s frozen by a meteor", player.UserID)
+			}
+		}
+	}
+}
+
+// dropMeteor aims a meteor at passable ground near a random player
+func (ms *MeteorShower) dropMeteor(gsm *GameStateManager, now time.Time) (*Meteor, bool) {
+	var targets []*PlayerState
+	for _, player := range inIDOrder(gsm.players) {
+		if !player.Eliminated {
+			targets = append(targets, player)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, false
+	}
+
+	target := targets[gsm.rng.Intn(len(targets))]
+	angle := gsm.rng.Float64() * 2 * math.Pi
+	offset := gsm.rng.Float32() * MeteorScatter
+	point, ok := gsm.gameMap.NearestPassablePoint(
+		target.X+offset*float32(math.Cos(angle)),
+		target.Y+offset*float32(math.Sin(angle)),
+	)
+	if !ok {
+		return nil, false
+	}
+
+	impactAt := now.Add(MeteorWarning)
+	return &Meteor{X: point.X, Y: point.Y, ImpactAt: impactAt, FadesAt: impactAt.Add(MeteorZoneDuration)}, true
+}
+
+func (ms *MeteorShower) End(gsm *GameStateManager, now time.Time) {
+	ms.meteors = nil
+}
+
+func (ms *MeteorShower) Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent) {
+	for _, meteor := range ms.meteors {
+		state.Meteors = append(state.Meteors, &multiplayerv1.Meteor{
+			Position:   &multiplayerv1.Vector2{X: meteor.X, Y: meteor.Y},
+			Radius:     MeteorRadius,
+			ImpactAtMs: unixMillis(meteor.ImpactAt),
+			FadesAtMs:  unixMillis(meteor.FadesAt),
+		})
+	}
+}
+
+// AloeBloom grows a cluster of aloe around one spot. Whatever is left when it ends withers.
+type AloeBloom struct {
+	centerX, centerY float32
+	itemIDs          []string
+}
+
+func (*AloeBloom) Kind() multiplayerv1.WorldEventKind {
+	return multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_ALOE_BLOOM
+}
+
+func (bloom *AloeBloom) Start(gsm *GameStateManager, now time.Time) bool {
+	tileX, tileY, ok := gsm.gameMap.RandomPassableTile(gsm.rng)
+	if !ok {
+		return false
+	}
+	halfTile := float32(gsm.gameMap.TileSize) / 2
+	bloom.centerX = float32(tileX*gsm.gameMap.TileSize) + halfTile
+	bloom.centerY = float32(tileY*gsm.gameMap.TileSize) + halfTile
+
+	for range AloeBloomCount {
+		angle := gsm.rng.Float64() * 2 * math.Pi
+		offset := float32(math.Sqrt(gsm.rng.Float64())) * AloeBloomRadius
+		point, ok := gsm.gameMap.NearestPassablePoint(
+			bloom.centerX+offset*float32(math.Cos(angle)),
+			bloom.centerY+offset*float32(math.Sin(angle)),
+		)
+		if !ok {
+			continue
+		}
+		bloom.itemIDs = append(bloom.itemIDs, gsm.itemManager.SpawnItemAt(ItemTypeAloe, point.X, point.Y, now))
+	}
+
+	logger.Debug("[AloeBloom] %d aloe bloomed at (%.1f, %.1f)", len(bloom.itemIDs), bloom.centerX, bloom.centerY)
+	return len(bloom.itemIDs) > 0
+}
+
+func (*AloeBloom) Update(gsm *GameStateManager, now time.Time) {}
+
+func (bloom *AloeBloom) End(gsm *GameStateManager, now time.Time) {
+	gsm.itemManager.RemoveItems(bloom.itemIDs)
+	bloom.itemIDs = nil
+}
+
+func (bloom *AloeBloom) Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent) {
+	state.Center = &multiplayerv1.Vector2{X: bloom.centerX, Y: bloom.centerY}
+	state.Radius = AloeBloomRadius
+}
@@ -0,0 +1,53 @@
+package hub
+
+import (
+	"testing"
+	"time"
+)
+
+const testMapPath = "assets/multiplayer_map.json"
+
+// newTestSetup reads the shipped map for a room playing mode with the built-in
+// spawn table and event schedule
+func newTestSetup(tb testing.TB, mode string, seed int64) *SimulationSetup {
+	tb.Helper()
+
+	setup := &SimulationSetup{
+		MapPath:  testMapPath,
+		GameMode: mode,
+		TickRate: RoomTickRate,
+		Seed:     seed,
+	}
+	if err := setup.readFiles(); err != nil {
+		tb.Fatalf("failed to read setup files: %v", err)
+	}
+	return setup
+}
+
+// newTestRoom builds a room from setup the way newRoom does, but without Redis,
+// clients or a running loop. Its manual clock only moves when the test advances it.
+func newTestRoom(tb testing.TB, setup *SimulationSetup) (*Room, *ManualClock) {
+	tb.Helper()
+
+	room := &Room{
+		ID:      "test",
+		keys:    newRoomKeys("test"),
+		clients: make(map[*Client]bool),
+	}
+	clock := NewManualClock(time.Unix(1_700_000_000, 0))
+	if err := room.buildSimulation(setup, clock, nil); err != nil {
+		tb.Fatalf("failed to build simulation: %v", err)
+	}
+	return room, clock
+}
+
+// addTestBots adds bots the way BotManager.Initialize does, minus Redis
+func addTestBots(room *Room, count int) {
+	bm := room.botManager
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	for range count {
+		bm.addBot(bm.nextBotID(), bm.generateBotName(len(bm.bots)+1, nil))
+	}
+}
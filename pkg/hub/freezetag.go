@@ -0,0 +1,158 @@
+package hub
+
+import (
+	"math"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	FreezeTagTeams                  = 2
+	FreezeTagFreezeDuration         = 30 * time.Second // Frozen players wait on a teammate, this only stops a freeze lasting forever
+	FreezeTagThawTime               = 2 * time.Second  // Time a teammate must stay close to thaw someone
+	FreezeTagThawRadius     float32 = 48
+	ScorePerThaw                    = 2
+)
+
+// FreezeTag splits players into teams. Potions only freeze opponents, frozen players
+// stay frozen until a teammate stands next to them long enough, and a team wins
+// once every opponent is frozen at the same time.
+type FreezeTag struct {
+	lastUpdate time.Time
+}
+
+func NewFreezeTag() *FreezeTag {
+	return &FreezeTag{}
+}
+
+func (*FreezeTag) Type() multiplayerv1.GameMode {
+	return multiplayerv1.GameMode_GAME_MODE_FREEZE_TAG
+}
+
+func (*FreezeTag) Teams() int                    { return FreezeTagTeams }
+func (*FreezeTag) ScoreLimit() int               { return 0 }
+func (*FreezeTag) Respawns() bool                { return true }
+func (*FreezeTag) FreezeDuration() time.Duration { return FreezeTagFreezeDuration }
+
+func (ft *FreezeTag) StartRound(gsm *GameStateManager, now time.Time) {
+	gsm.balanceTeams()
+	ft.lastUpdate = now
+}
+
+func (ft *FreezeTag) Update(gsm *GameStateManager, now time.Time) (int, bool) {
+	elapsed := now.Sub(ft.lastUpdate)
+	if ft.lastUpdate.IsZero() {
+		elapsed = 0
+	}
+	ft.lastUpdate = now
+
+	ft.updateThaws(gsm, now, elapsed)
+	return ft.winningTeam(gsm)
+}
+
+// updateThaws advances the thaw of every frozen player with an active teammate next to them
+func (ft *FreezeTag) updateThaws(gsm *GameStateManager, now time.Time, elapsed time.Duration) {
+	radiusSq := FreezeTagThawRadius * FreezeTagThawRadius
+
+	for _, frozen := range inIDOrder(gsm.players) {
+		if !frozen.HasStatus(StatusFrozen) {
+			frozen.ThawProgress = 0
+			continue
+		}
+
+		var rescuer *PlayerState
+		for _, other := range inIDOrder(gsm.players) {
+			if other == frozen || !teammates(frozen, other) || other.IsDisabled() {
+				continue
+			}
+			dx := other.X - frozen.X
+			dy := other.Y - frozen.Y
+			if dx*dx+dy*dy <= radiusSq {
+				rescuer = other
+				break
+			}
+		}
+
+		// Thawing has to be uninterrupted
+		if rescuer == nil {
+			frozen.ThawProgress = 0
+			continue
+		}
+
+		frozen.ThawProgress += elapsed
+		if frozen.ThawProgress >= FreezeTagThawTime {
+			frozen.ThawProgress = 0
+			gsm.expireStatus(frozen, StatusFrozen, now)
+			gsm.matchManager.RecordThaw(rescuer.UserID)
+			logger.Debug("Player %s thawed by teammate %s", frozen.UserID, rescuer.UserID)
+		}
+	}
+}
+
+// winningTeam returns the only team with anyone left standing, once every other
+// team that has players is completely frozen or eliminated
+func (ft *FreezeTag) winningTeam(gsm *GameStateManager) (int, bool) {
+	standing := make([]int, FreezeTagTeams+1)
+	members := make([]int, FreezeTagTeams+1)
+	for _, player := range gsm.players {
+		if player.Team <= 0 || player.Team > FreezeTagTeams {
+			continue
+		}
+		members[player.Team]++
+		if !player.IsDisabled() {
+			standing[player.Team]++
+		}
+	}
+
+	winner := 0
+	for team := 1; team <= FreezeTagTeams; team++ {
+		if members[team] == 0 {
+			// A team with no players cannot lose or win
+			return 0, false
+		}
+		if standing[team] > 0 {
+			if winner != 0 {
+				return 0, false
+			}
+			winner = team
+		}
+	}
+	return winner, winner != 0
+}
+
+func (ft *FreezeTag) Reset(gsm *GameStateManager) {
+	ft.lastUpdate = time.Time{}
+}
+
+// KeepsFreezes leaves thawing to teammates
+func (*FreezeTag) KeepsFreezes() bool { return true }
+
+// BotGoal sends bots to the nearest frozen teammate to thaw them
+func (ft *FreezeTag) BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool) {
+	bot, exists := gsm.players[botID]
+	if !exists {
+		return BotGoal{}, false
+	}
+
+	var goal *PlayerState
+	nearest := float32(math.MaxFloat32)
+	for _, player := range inIDOrder(gsm.players) {
+		if player == bot || !teammates(bot, player) || !player.HasStatus(StatusFrozen) {
+			continue
+		}
+		if dist := distance(bot.X, bot.Y, player.X, player.Y); dist < nearest {
+			nearest = dist
+			goal = player
+		}
+	}
+
+	if goal == nil {
+		return BotGoal{}, false
+	}
+	return BotGoal{X: goal.X, Y: goal.Y}, true
+}
+
+func (ft *FreezeTag) Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time) {
+}
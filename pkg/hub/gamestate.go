@@ -11,16 +11,17 @@ import (
 )
 
 const (
-	PlayerRadius            float32 = 16
-	PlayerSpeed             float32 = 150
-	SlowdownSpeed           float32 = 60
-	FreezeImmunityTime      float64 = 3.0
-	SpeedBoostDuration      float64 = 2.0
-	SpeedBoostMultiplier    float32 = 1.2
-	QuicksandEventInterval          = 30 * time.Second
-	QuicksandEventDuration          = 20 * time.Second
-	QuicksandEventTileCount         = 100
-	QuicksandEventTileID            = 237
+	PlayerRadius         float32 = 16
+	PlayerSpeed          float32 = 150
+	SlowdownSpeed        float32 = 60
+	FreezeImmunityTime   float64 = 3.0
+	SpeedBoostDuration   float64 = 2.0
+	SpeedBoostMultiplier float32 = 1.2
+	SpawnCandidates              = 12 // Spawn points sampled when looking for the safest one
+
+	// MaxSimulationLag is how far the simulation may fall behind the clock before it
+	// skips ahead instead of catching up one step at a time
+	MaxSimulationLag = time.Second
 )
 
 type PlayerState struct {
@@ -34,65 +35,158 @@ type PlayerState struct {
 	MoveLeft  bool
 	MoveRight bool
 
-	IsFrozen        bool
-	FrozenUntil     time.Time
-	FreezeImmunity  time.Time
-	AloeCount       int
-	SpeedBoostUntil time.Time
+	// LastInputSeq is the sequence of the latest input applied, echoed back for client reconciliation
+	LastInputSeq uint32
+
+	AloeCount int
+
+	Health     int
+	Eliminated bool
+	RespawnAt  time.Time
+
+	// Team is the player's team in team modes, 0 in free-for-all
+	Team int
+	// ThawProgress is how long teammates have been thawing this frozen player
+	ThawProgress time.Duration
+
+	// Effects holds active status effects such as freeze, immunity and speed boost
+	Effects []*StatusEffect
+
+	// Cooldowns holds when each ability is next usable
+	Cooldowns map[multiplayerv1.ActionType]time.Time
 }
 
 type GameStateManager struct {
-	mu                 sync.RWMutex
-	players            map[string]*PlayerState
-	hub                *Hub
-	gameMap            *GameMap
-	tickRate           time.Duration
-	lastTick           time.Time
-	projectileManager  *ProjectileManager
-	itemManager        *ItemManager
-	quicksandTiles     map[int]struct{}
-	quicksandExpiresAt time.Time
-	nextQuicksandAt    time.Time
-}
-
-func NewGameStateManager(hub *Hub, gameMap *GameMap, tickRate time.Duration) *GameStateManager {
+	mu                sync.RWMutex
+	players           map[string]*PlayerState
+	room              *Room
+	gameMap           *GameMap
+	tickRate          time.Duration
+	clock             Clock
+	lastTick          time.Time     // Clock time of the last tick
+	accumulator       time.Duration // Clock time not yet simulated
+	simTime           time.Time     // Advances by exactly tickRate per step
+	seed              int64
+	rng               *rand.Rand
+	projectileManager *ProjectileManager
+	itemManager       *ItemManager
+	matchManager      *MatchManager
+	mode              GameMode
+	inputs            *inputQueue
+	tickNumber        int64
+	nextMinimapAt     time.Time
+	events            *EventScheduler
+	recorder          *ReplayRecorder // nil unless the room is being recorded
+	done              chan struct{}
+	stopOnce          sync.Once
+}
+
+// NewGameStateManager creates a room's simulation. It steps in fixed tickRate
+// increments of clock time, and every random choice it makes comes from seed.
+func NewGameStateManager(room *Room, gameMap *GameMap, tickRate time.Duration, clock Clock, seed int64) *GameStateManager {
+	start := clock.Now()
 	gsm := &GameStateManager{
-		players:         make(map[string]*PlayerState),
-		hub:             hub,
-		gameMap:         gameMap,
-		tickRate:        tickRate,
-		lastTick:        time.Now(),
-		quicksandTiles:  make(map[int]struct{}),
-		nextQuicksandAt: time.Now().Add(QuicksandEventInterval),
+		players:  make(map[string]*PlayerState),
+		room:     room,
+		gameMap:  gameMap,
+		tickRate: tickRate,
+		clock:    clock,
+		lastTick: start,
+		simTime:  start,
+		seed:     seed,
+		rng:      NewRoomRand(seed),
+		events:   NewEventScheduler(DefaultEventSchedule(), start),
+		inputs:   newInputQueue(),
+		mode:     &FreeForAll{},
+		done:     make(chan struct{}),
 	}
 	gsm.projectileManager = NewProjectileManager(gsm)
-	gsm.itemManager = NewItemManager(gsm)
+	gsm.itemManager = NewItemManager(gsm, gsm.rng)
+	gsm.matchManager = NewMatchManager(gsm)
 	return gsm
 }
 
+// Seed returns the seed the room's random source was created with
+func (gsm *GameStateManager) Seed() int64 {
+	return gsm.seed
+}
+
+// now returns the current simulation time. Only the simulation loop advances it, so
+// callers must either hold gsm.mu or run on the loop, as step and the constructors do.
+func (gsm *GameStateManager) now() time.Time {
+	return gsm.simTime
+}
+
 // AddPlayer spawns a player at a random valid position within map bounds
 func (gsm *GameStateManager) AddPlayer(userID string, username string) {
+	gsm.addPlayer(userID, username, false)
+}
+
+func (gsm *GameStateManager) addPlayer(userID string, username string, bot bool) {
 	gsm.mu.Lock()
 	defer gsm.mu.Unlock()
 
+	gsm.record(&multiplayerv1.ReplayEvent{
+		Type:     multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_JOIN,
+		UserId:   userID,
+		Username: username,
+		Bot:      bot,
+	})
+
 	// Server generates spawn position (client suggestion is ignored for security)
-	// Keep trying until we find a position not in collision
+	spawnX, spawnY := gsm.selectSpawnPoint(userID)
+
+	player := &PlayerState{
+		UserID:   userID,
+		Username: username,
+		X:        spawnX,
+		Y:        spawnY,
+		Health:   PlayerMaxHealth,
+		Team:     gsm.smallestTeam(),
+	}
+	gsm.applyStatus(player, StatusSpawnProtection, gsm.now(), "")
+	gsm.players[userID] = player
+	gsm.matchManager.AddPlayer(userID, username)
+}
+
+// randomSpawnPoint keeps trying until it finds a position not in collision
+func (gsm *GameStateManager) randomSpawnPoint() (float32, float32) {
 	var spawnX, spawnY float32
 	maxAttempts := 100
 	for range maxAttempts {
-		spawnX = PlayerRadius + rand.Float32()*(gsm.gameMap.PixelWidth-2*PlayerRadius)
-		spawnY = PlayerRadius + rand.Float32()*(gsm.gameMap.PixelHeight-2*PlayerRadius)
+		spawnX = PlayerRadius + gsm.rng.Float32()*(gsm.gameMap.PixelWidth-2*PlayerRadius)
+		spawnY = PlayerRadius + gsm.rng.Float32()*(gsm.gameMap.PixelHeight-2*PlayerRadius)
 		if gsm.gameMap.IsValidSpawnPoint(spawnX, spawnY, PlayerRadius) {
 			break
 		}
 	}
+	return spawnX, spawnY
+}
 
-	gsm.players[userID] = &PlayerState{
-		UserID:   userID,
-		Username: username,
-		X:        spawnX,
-		Y:        spawnY,
+// selectSpawnPoint samples several valid spawn points and picks the one farthest
+// from other active players and in-flight projectiles. Caller must hold gsm.mu.
+func (gsm *GameStateManager) selectSpawnPoint(userID string) (float32, float32) {
+	var bestX, bestY float32
+	bestDistSq := float32(-1)
+
+	for range SpawnCandidates {
+		x, y := gsm.randomSpawnPoint()
+
+		nearestSq := gsm.projectileManager.NearestActiveDistSq(x, y)
+		for id, other := range gsm.players {
+			if id == userID || other.Eliminated {
+				continue
+			}
+			dx := other.X - x
+			dy := other.Y - y
+			nearestSq = min(nearestSq, dx*dx+dy*dy)
+		}
+
+		if nearestSq > bestDistSq {
+			bestX, bestY, bestDistSq = x, y, nearestSq
+		}
 	}
+	return bestX, bestY
 }
 
 // GetPlayerPosition returns the server-authoritative position for a player
@@ -110,79 +204,78 @@ func (gsm *GameStateManager) RemovePlayer(userID string) {
 	gsm.mu.Lock()
 	defer gsm.mu.Unlock()
 
+	gsm.record(&multiplayerv1.ReplayEvent{
+		Type:   multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_LEAVE,
+		UserId: userID,
+	})
 	delete(gsm.players, userID)
+	gsm.matchManager.RemovePlayer(userID)
 }
 
-// UpdatePlayerInputAction updates a single input state based on key press/release event
-func (gsm *GameStateManager) UpdatePlayerInputAction(userID string, inputAction *multiplayerv1.InputAction) {
+// IdlePlayer releases all held inputs so a disconnected player stands still.
+// Returns false if the player is not in the game.
+func (gsm *GameStateManager) IdlePlayer(userID string) bool {
 	gsm.mu.Lock()
 	defer gsm.mu.Unlock()
 
-	if player, exists := gsm.players[userID]; exists && inputAction != nil {
-		switch inputAction.Input {
-		case multiplayerv1.InputType_INPUT_TYPE_MOVE_UP:
-			player.MoveUp = inputAction.Pressed
-		case multiplayerv1.InputType_INPUT_TYPE_MOVE_DOWN:
-			player.MoveDown = inputAction.Pressed
-		case multiplayerv1.InputType_INPUT_TYPE_MOVE_LEFT:
-			player.MoveLeft = inputAction.Pressed
-		case multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT:
-			player.MoveRight = inputAction.Pressed
-		}
+	player, exists := gsm.players[userID]
+	if !exists {
+		return false
 	}
+	gsm.record(&multiplayerv1.ReplayEvent{
+		Type:   multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_IDLE,
+		UserId: userID,
+	})
+	player.MoveUp = false
+	player.MoveDown = false
+	player.MoveLeft = false
+	player.MoveRight = false
+	return true
 }
 
-// FreezePlayer freezes a player for the specified duration in seconds
-func (gsm *GameStateManager) FreezePlayer(userID string, duration float64) {
-	gsm.mu.Lock()
-	defer gsm.mu.Unlock()
+// applyInputAction sets the movement flag matching a key press/release
+func applyInputAction(player *PlayerState, inputAction *multiplayerv1.InputAction) {
+	if inputAction == nil {
+		return
+	}
 
-	if player, exists := gsm.players[userID]; exists {
-		player.IsFrozen = true
-		player.FrozenUntil = time.Now().Add(time.Duration(duration * float64(time.Second)))
-		player.AloeCount = 0
-		player.SpeedBoostUntil = time.Time{}
+	switch inputAction.Input {
+	case multiplayerv1.InputType_INPUT_TYPE_MOVE_UP:
+		player.MoveUp = inputAction.Pressed
+	case multiplayerv1.InputType_INPUT_TYPE_MOVE_DOWN:
+		player.MoveDown = inputAction.Pressed
+	case multiplayerv1.InputType_INPUT_TYPE_MOVE_LEFT:
+		player.MoveLeft = inputAction.Pressed
+	case multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT:
+		player.MoveRight = inputAction.Pressed
 	}
-}
 
-// updateFreezeStates checks and unfreezes players whose freeze duration has expired
-func (gsm *GameStateManager) updateFreezeStates() {
-	now := time.Now()
-	for _, player := range gsm.players {
-		if player.IsFrozen && now.After(player.FrozenUntil) {
-			player.IsFrozen = false
-			player.FreezeImmunity = now.Add(time.Duration(FreezeImmunityTime * float64(time.Second)))
-			player.SpeedBoostUntil = now.Add(time.Duration(SpeedBoostDuration * float64(time.Second)))
-		}
+	// Inputs arrive in order per connection, so the latest sequence is the highest processed
+	if inputAction.Sequence != 0 {
+		player.LastInputSeq = inputAction.Sequence
 	}
 }
 
-// IsPlayerFrozen returns whether a player is currently frozen
-func (gsm *GameStateManager) IsPlayerFrozen(userID string) bool {
-	gsm.mu.RLock()
-	defer gsm.mu.RUnlock()
+// FreezePlayer freezes a player unless they are a teammate of the source or an active effect protects them
+func (gsm *GameStateManager) FreezePlayer(userID string, sourceID string) bool {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
 
-	if player, exists := gsm.players[userID]; exists {
-		return player.IsFrozen
+	if player, exists := gsm.players[userID]; exists && !player.Eliminated {
+		return gsm.freezePlayer(player, gsm.now(), sourceID)
 	}
 	return false
 }
 
-// SpawnFreezePotion spawns a freeze potion projectile from the given player
-func (gsm *GameStateManager) SpawnFreezePotion(ownerID string, targetX, targetY float32) string {
+// IsPlayerDisabled returns whether a player is currently frozen or eliminated
+func (gsm *GameStateManager) IsPlayerDisabled(userID string) bool {
 	gsm.mu.RLock()
-	player, exists := gsm.players[ownerID]
-	gsm.mu.RUnlock()
-
-	if !exists {
-		return ""
-	}
+	defer gsm.mu.RUnlock()
 
-	if player.IsFrozen {
-		return ""
+	if player, exists := gsm.players[userID]; exists {
+		return player.IsDisabled()
 	}
-
-	return gsm.projectileManager.SpawnFreezePotion(ownerID, player.X, player.Y, targetX, targetY)
+	return false
 }
 
 // GetProjectileManager returns the projectile manager
@@ -190,6 +283,14 @@ func (gsm *GameStateManager) GetProjectileManager() *ProjectileManager {
 	return gsm.projectileManager
 }
 
+// unixMillis converts a server time to Unix milliseconds, keeping the zero time as 0
+func unixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
 // clamp restricts a value to be within [min, max]
 func clamp(value, min, max float32) float32 {
 	if value < min {
@@ -204,20 +305,19 @@ func clamp(value, min, max float32) float32 {
 // simulateMovement processes all player inputs and updates positions
 func (gsm *GameStateManager) simulateMovement(deltaSeconds float32, now time.Time) {
 	for _, player := range gsm.players {
-		// Skip movement if frozen
-		if player.IsFrozen {
+		// Skip movement if eliminated or rooted by an effect
+		multiplier := player.SpeedMultiplier()
+		if player.Eliminated || multiplier == 0 {
 			continue
 		}
 
 		// Determine speed based on terrain
 		speed := PlayerSpeed
-		if gsm.gameMap.IsInSlowdown(player.X, player.Y) || gsm.isInQuicksand(player.X, player.Y) {
+		if gsm.gameMap.IsInSlowdown(player.X, player.Y) || gsm.events.slowsAt(gsm.gameMap, player.X, player.Y) {
 			speed = SlowdownSpeed
 		}
 
-		if now.Before(player.SpeedBoostUntil) {
-			speed *= SpeedBoostMultiplier
-		}
+		speed *= multiplier
 
 		var velocityX, velocityY float32 = 0, 0
 
@@ -287,147 +387,188 @@ func (gsm *GameStateManager) GetMapDimensions() (float32, float32) {
 	return gsm.gameMap.PixelWidth, gsm.gameMap.PixelHeight
 }
 
-func (gsm *GameStateManager) updateQuicksandEvent(now time.Time) {
-	if !gsm.quicksandExpiresAt.IsZero() && now.After(gsm.quicksandExpiresAt) {
-		logger.Debug("[Quicksand] Event expired, clearing %d tiles", len(gsm.quicksandTiles))
-		gsm.quicksandTiles = make(map[int]struct{})
-		gsm.quicksandExpiresAt = time.Time{}
+// getQuicksandEventState mirrors a running quicksand event in the legacy GameState field
+func (gsm *GameStateManager) getQuicksandEventState() *multiplayerv1.QuicksandEvent {
+	running := gsm.events.find(multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_QUICKSAND)
+	if running == nil {
+		return nil
 	}
 
-	if now.Before(gsm.nextQuicksandAt) {
-		return
+	expiresAtUnix := float32(running.endsAt.Unix())
+	return &multiplayerv1.QuicksandEvent{
+		Tiles:       running.event.(*Quicksand).tileCoords(gsm.gameMap),
+		ExpiresAt:   expiresAtUnix,
+		TileId:      QuicksandEventTileID,
+		ExpiresAtMs: running.endsAt.UnixMilli(),
 	}
+}
 
-	quicksandTiles := make(map[int]struct{})
-	maxAttempts := QuicksandEventTileCount * 10
-	for attempts := 0; len(quicksandTiles) < QuicksandEventTileCount && attempts < maxAttempts; attempts++ {
-		tileX, tileY, ok := gsm.gameMap.RandomPassableTile()
-		if !ok {
-			break
+func (gsm *GameStateManager) Start() {
+	ticker := time.NewTicker(gsm.tickRate)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-gsm.done:
+				gsm.stopRecording()
+				return
+			case <-ticker.C:
+				gsm.tick()
+			}
 		}
-		key := tileY*gsm.gameMap.Width + tileX
-		quicksandTiles[key] = struct{}{}
-	}
+	}()
+}
 
-	gsm.quicksandTiles = quicksandTiles
-	gsm.quicksandExpiresAt = now.Add(QuicksandEventDuration)
-	gsm.nextQuicksandAt = now.Add(QuicksandEventInterval)
-	logger.Debug("[Quicksand] New event started with %d tiles, expires at %v", len(quicksandTiles), gsm.quicksandExpiresAt)
+// Stop halts the simulation loop started by Start
+func (gsm *GameStateManager) Stop() {
+	gsm.stopOnce.Do(func() {
+		close(gsm.done)
+	})
 }
 
-func (gsm *GameStateManager) isInQuicksand(x, y float32) bool {
-	if len(gsm.quicksandTiles) == 0 {
-		return false
+// tick runs as many fixed simulation steps as the clock has moved since the last
+// tick and broadcasts state
+func (gsm *GameStateManager) tick() {
+	clockNow := gsm.clock.Now()
+	gsm.accumulator += clockNow.Sub(gsm.lastTick)
+	gsm.lastTick = clockNow
+
+	// After a long stall, skip ahead rather than replaying the backlog in a burst
+	if gsm.accumulator > MaxSimulationLag {
+		skipped := gsm.accumulator - gsm.tickRate
+		logger.Warn("[Room %s] Simulation fell %v behind, skipping ahead", gsm.room.ID, skipped)
+		gsm.skipAhead(skipped)
+		gsm.accumulator = gsm.tickRate
 	}
 
-	tileX := int(x) / gsm.gameMap.TileSize
-	tileY := int(y) / gsm.gameMap.TileSize
-
-	if tileX < 0 || tileX >= gsm.gameMap.Width || tileY < 0 || tileY >= gsm.gameMap.Height {
-		return false
+	stepped := false
+	for gsm.accumulator >= gsm.tickRate {
+		gsm.accumulator -= gsm.tickRate
+		gsm.step()
+		stepped = true
+	}
+	if !stepped {
+		return
 	}
 
-	key := tileY*gsm.gameMap.Width + tileX
-	_, exists := gsm.quicksandTiles[key]
-	return exists
-}
+	// Only the simulation loop advances simTime, so it can be read here unlocked
+	now := gsm.simTime
 
-func (gsm *GameStateManager) getQuicksandEventState() *multiplayerv1.QuicksandEvent {
-	if len(gsm.quicksandTiles) == 0 || gsm.quicksandExpiresAt.IsZero() {
-		return nil
-	}
+	// Broadcast to clients if any are connected
+	if gsm.hasConnectedClients() && len(gsm.players) > 0 {
+		gsm.broadcastGameState(now)
 
-	tiles := make([]*multiplayerv1.TileCoord, 0, len(gsm.quicksandTiles))
-	for key := range gsm.quicksandTiles {
-		tileX := key % gsm.gameMap.Width
-		tileY := key / gsm.gameMap.Width
-		tiles = append(tiles, &multiplayerv1.TileCoord{
-			X: int32(tileX),
-			Y: int32(tileY),
-		})
+		if !now.Before(gsm.nextMinimapAt) {
+			gsm.broadcastMinimap()
+			gsm.nextMinimapAt = now.Add(MinimapInterval)
+		}
 	}
+}
 
-	expiresAtUnix := float32(gsm.quicksandExpiresAt.Unix())
-	return &multiplayerv1.QuicksandEvent{
-		Tiles:     tiles,
-		ExpiresAt: expiresAtUnix,
-		TileId:    QuicksandEventTileID,
-	}
+// skipAhead moves simulation time forward without running any steps
+func (gsm *GameStateManager) skipAhead(skipped time.Duration) {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	gsm.simTime = gsm.simTime.Add(skipped)
+	gsm.record(&multiplayerv1.ReplayEvent{
+		Type:      multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_SKIP,
+		SkippedNs: int64(skipped),
+	})
 }
 
-func (gsm *GameStateManager) Start() {
-	ticker := time.NewTicker(gsm.tickRate)
-	go func() {
-		for range ticker.C {
-			gsm.tick()
-		}
-	}()
+// Step advances the simulation by exactly one tick. The room's loop calls it through
+// tick; tests and replays can call it directly to drive a room without a clock.
+func (gsm *GameStateManager) Step() {
+	gsm.step()
 }
 
-// tick runs the game simulation and broadcasts state
-func (gsm *GameStateManager) tick() {
-	now := time.Now()
-	_, botActions := gsm.updateGameState(now)
+func (gsm *GameStateManager) step() {
+	// Apply player inputs queued since the last step before simulating
+	playerActions := gsm.applyQueuedInputs()
+	gsm.executePlayerActions(playerActions)
+
+	_, botActions, results := gsm.updateGameState()
 
 	// Execute bot actions after releasing lock (avoids deadlock)
 	gsm.executeBotActions(botActions)
 
-	// Broadcast to clients if any are connected
-	if gsm.hasConnectedClients() && len(gsm.players) > 0 {
-		gsm.broadcastGameState(now)
+	if results != nil {
+		gsm.broadcastMatchResults(results)
 	}
 
 	gsm.projectileManager.CleanupInactiveProjectiles()
 }
 
-// updateGameState runs all game simulation logic and returns bot actions to execute
-func (gsm *GameStateManager) updateGameState(now time.Time) (float32, []BotAction) {
+// updateGameState runs all game simulation logic for one step and returns bot actions
+// to execute along with the results of a round that ended this step, if any
+func (gsm *GameStateManager) updateGameState() (float32, []BotAction, *multiplayerv1.MatchResults) {
 	gsm.mu.Lock()
 	defer gsm.mu.Unlock()
 
-	// Calculate delta time since last tick
-	deltaSeconds := float32(now.Sub(gsm.lastTick).Seconds())
-	gsm.lastTick = now
+	// Every step covers exactly one tick, however late the loop ran it
+	deltaSeconds := float32(gsm.tickRate.Seconds())
+	gsm.simTime = gsm.simTime.Add(gsm.tickRate)
+	now := gsm.simTime
+	gsm.tickNumber++
+
+	// Advance the match before simulating so a reset round starts clean
+	results := gsm.matchManager.Update(now)
 
 	// Update game systems
-	gsm.updateFreezeStates()
-	gsm.updateQuicksandEvent(now)
+	gsm.updateStatusEffects(now)
+	gsm.updateRespawns(now)
+	gsm.events.Update(gsm, now)
 	gsm.itemManager.Update(now, gsm.players)
 
 	// Update bot AI (always runs, even with no human clients)
 	var botActions []BotAction
-	if gsm.hub.botManager != nil {
-		botActions = gsm.hub.botManager.Update(now, gsm.players)
+	if gsm.room.botManager != nil {
+		botActions = gsm.room.botManager.Update(now, gsm.players)
 	}
 
 	// Update projectiles and movement
 	gsm.projectileManager.Update(deltaSeconds, gsm.players)
 	gsm.simulateMovement(deltaSeconds, now)
 
-	return deltaSeconds, botActions
+	return deltaSeconds, botActions, results
 }
 
-// executeBotActions spawns projectiles for bot actions (must be called after releasing gsm.mu)
+// executeBotActions uses abilities for bot actions (must be called after releasing gsm.mu)
 func (gsm *GameStateManager) executeBotActions(actions []BotAction) {
 	for _, action := range actions {
-		gsm.SpawnFreezePotion(action.BotID, action.TargetX, action.TargetY)
+		gsm.UseAbility(action.BotID, action.Action, action.TargetX, action.TargetY)
 	}
 }
 
-// hasConnectedClients returns true if there are any connected WebSocket clients
+// hasConnectedClients returns true if there are any WebSocket clients in the room
 func (gsm *GameStateManager) hasConnectedClients() bool {
-	gsm.hub.clientsMu.RLock()
-	defer gsm.hub.clientsMu.RUnlock()
-	return len(gsm.hub.clients) > 0
+	return gsm.room.getTotalClients() > 0
 }
 
-// broadcastGameState builds and sends the current game state to all clients
+// broadcastGameState builds and sends the current game state to all clients in the room
 func (gsm *GameStateManager) broadcastGameState(now time.Time) {
+	gameState, visionScale := gsm.buildGameState(now)
+
+	view := newWorldView(gsm.gameMap, gameState)
+	view.visionScale = visionScale
+
+	// Ticks only increase, so they double as snapshot IDs for acks
+	gsm.room.broadcastSnapshot(gameState.Tick, view)
+}
+
+// buildGameState captures the whole world along with the fraction of the normal
+// view radius players currently see
+func (gsm *GameStateManager) buildGameState(now time.Time) (*multiplayerv1.GameState, float32) {
 	gsm.mu.RLock()
 	playerStates := gsm.buildPlayerStates(now)
 	projectileStates := gsm.projectileManager.GetActiveProjectiles()
 	itemStates := gsm.itemManager.GetActiveItems()
 	quicksandEvent := gsm.getQuicksandEventState()
+	worldEvents := gsm.events.buildWorldEvents(gsm)
+	visionScale := gsm.events.visionScale()
+	matchState := gsm.matchManager.GetMatchState(now)
+	tickNumber := gsm.tickNumber
 	gsm.mu.RUnlock()
 
 	gameState := &multiplayerv1.GameState{
@@ -435,22 +576,85 @@ func (gsm *GameStateManager) broadcastGameState(now time.Time) {
 		Projectiles:    projectileStates,
 		Items:          itemStates,
 		QuicksandEvent: quicksandEvent,
+		Match:          matchState,
+		Tick:           tickNumber,
+		ServerTimeMs:   now.UnixMilli(),
+		WorldEvents:    worldEvents,
+	}
+	return gameState, visionScale
+}
+
+// broadcastMinimap sends coarse positions of every player to all clients in the room
+func (gsm *GameStateManager) broadcastMinimap() {
+	gsm.mu.RLock()
+	minimapState := gsm.buildMinimapState()
+	gsm.mu.RUnlock()
+
+	gameMsg := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_MINIMAP_STATE,
+		Payload: &multiplayerv1.GameMessage_MinimapState{
+			MinimapState: minimapState,
+		},
+	}
+
+	wire, err := proto.Marshal(gameMsg)
+	if err != nil {
+		logger.Error("Failed to marshal minimap state: %v", err)
+		return
 	}
 
+	gsm.room.broadcastToClients(wire)
+}
+
+// broadcastMatchResults sends the final standings of a round to all clients in the room
+func (gsm *GameStateManager) broadcastMatchResults(results *multiplayerv1.MatchResults) {
 	gameMsg := &multiplayerv1.GameMessage{
-		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE,
-		Payload: &multiplayerv1.GameMessage_GameState{
-			GameState: gameState,
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_MATCH_RESULTS,
+		Payload: &multiplayerv1.GameMessage_MatchResults{
+			MatchResults: results,
 		},
 	}
 
 	wire, err := proto.Marshal(gameMsg)
 	if err != nil {
-		logger.Error("Failed to marshal game state: %v", err)
+		logger.Error("Failed to marshal match results: %v", err)
 		return
 	}
 
-	gsm.hub.broadcastToClients(wire)
+	gsm.room.broadcastToClients(wire)
+}
+
+// resetRound respawns every player and clears projectiles, items and events for the next round.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) resetRound(now time.Time) {
+	for _, player := range inIDOrder(gsm.players) {
+		player.X, player.Y = gsm.selectSpawnPoint(player.UserID)
+		player.MoveUp = false
+		player.MoveDown = false
+		player.MoveLeft = false
+		player.MoveRight = false
+		player.AloeCount = 0
+		player.Health = PlayerMaxHealth
+		player.Eliminated = false
+		player.RespawnAt = time.Time{}
+		player.Effects = nil
+		player.ThawProgress = 0
+		player.Cooldowns = nil
+		gsm.applyStatus(player, StatusSpawnProtection, now, "")
+	}
+
+	gsm.mode.Reset(gsm)
+
+	gsm.projectileManager.Reset()
+	gsm.itemManager.Reset(now)
+
+	gsm.events.Reset(gsm, now)
+
+	if gsm.room.botManager != nil {
+		gsm.room.botManager.Reset()
+	}
+
+	logger.Debug("[Match] Reset %d players for the next round", len(gsm.players))
 }
 
 // buildPlayerStates converts internal player state to protobuf format
@@ -458,23 +662,38 @@ func (gsm *GameStateManager) buildPlayerStates(now time.Time) []*multiplayerv1.P
 	states := make([]*multiplayerv1.PlayerState, 0, len(gsm.players))
 
 	for _, player := range gsm.players {
-		var frozenUntilUnix float32
-		if player.IsFrozen {
-			frozenUntilUnix = float32(player.FrozenUntil.Unix())
+		// The per-effect fields mirror the effects list for older clients.
+		// Float seconds are kept alongside the ms fields which carry full precision.
+		frozenUntil := player.statusExpiry(StatusFrozen)
+		speedBoostUntil := player.statusExpiry(StatusSpeedBoost)
+
+		var frozenUntilUnix, speedBoostUntilUnix float32
+		if !frozenUntil.IsZero() {
+			frozenUntilUnix = float32(frozenUntil.Unix())
 		}
-
-		var speedBoostUntilUnix float32
-		if now.Before(player.SpeedBoostUntil) {
-			speedBoostUntilUnix = float32(player.SpeedBoostUntil.Unix())
+		if !speedBoostUntil.IsZero() {
+			speedBoostUntilUnix = float32(speedBoostUntil.Unix())
 		}
 
 		states = append(states, &multiplayerv1.PlayerState{
-			PlayerId:        &multiplayerv1.ID{Value: player.UserID},
-			Position:        &multiplayerv1.Vector2{X: player.X, Y: player.Y},
-			IsFrozen:        player.IsFrozen,
-			FrozenUntil:     frozenUntilUnix,
-			AloeCount:       int32(player.AloeCount),
-			SpeedBoostUntil: speedBoostUntilUnix,
+			PlayerId:            &multiplayerv1.ID{Value: player.UserID},
+			Position:            &multiplayerv1.Vector2{X: player.X, Y: player.Y},
+			IsFrozen:            player.HasStatus(StatusFrozen),
+			FrozenUntil:         frozenUntilUnix,
+			AloeCount:           int32(player.AloeCount),
+			SpeedBoostUntil:     speedBoostUntilUnix,
+			LastProcessedInput:  player.LastInputSeq,
+			FrozenUntilMs:       unixMillis(frozenUntil),
+			SpeedBoostUntilMs:   unixMillis(speedBoostUntil),
+			Health:              int32(player.Health),
+			MaxHealth:           PlayerMaxHealth,
+			IsEliminated:        player.Eliminated,
+			RespawnAtMs:         unixMillis(player.RespawnAt),
+			InvulnerableUntilMs: unixMillis(player.statusExpiry(StatusSpawnProtection)),
+			Cooldowns:           buildCooldowns(player, now),
+			Effects:             buildStatusEffects(player),
+			Team:                int32(player.Team),
+			ThawProgress:        float32(player.ThawProgress) / float32(FreezeTagThawTime),
 		})
 	}
 
@@ -0,0 +1,77 @@
+package hub
+
+import (
+	"time"
+
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	PlayerMaxHealth      = 100
+	RespawnDelay         = 5 * time.Second
+	SpawnInvulnerability = 2 * time.Second
+)
+
+// IsDisabled reports whether the player can neither move nor act
+func (p *PlayerState) IsDisabled() bool {
+	return p.Eliminated || p.ActionsBlocked()
+}
+
+// damagePlayer lowers a player's health and eliminates them at zero. Teammates
+// cannot damage each other. Caller must hold gsm.mu.
+func (gsm *GameStateManager) damagePlayer(player *PlayerState, amount int, attackerID string) {
+	if player.Eliminated || amount <= 0 || player.DamageBlocked() {
+		return
+	}
+	if attacker, exists := gsm.players[attackerID]; exists && teammates(attacker, player) {
+		return
+	}
+
+	player.Health = max(0, player.Health-amount)
+	logger.Debug("Player %s took %d damage from %s, %d HP left", player.UserID, amount, attackerID, player.Health)
+
+	if player.Health == 0 {
+		gsm.eliminatePlayer(player, attackerID)
+	}
+}
+
+// eliminatePlayer takes a player out of play until their respawn timer runs out.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) eliminatePlayer(player *PlayerState, attackerID string) {
+	player.Eliminated = true
+	player.RespawnAt = gsm.now().Add(RespawnDelay)
+	player.MoveUp = false
+	player.MoveDown = false
+	player.MoveLeft = false
+	player.MoveRight = false
+	player.AloeCount = 0
+	player.Effects = nil
+
+	gsm.matchManager.RecordElimination(attackerID)
+	logger.Info("Player %s eliminated by %s", player.UserID, attackerID)
+}
+
+// updateRespawns brings back eliminated players whose respawn timer has run out,
+// unless the mode keeps them out for the rest of the round. Caller must hold gsm.mu.
+func (gsm *GameStateManager) updateRespawns(now time.Time) {
+	if !gsm.mode.Respawns() && gsm.matchManager.IsLive() {
+		return
+	}
+
+	for _, player := range inIDOrder(gsm.players) {
+		if player.Eliminated && !now.Before(player.RespawnAt) {
+			gsm.respawnPlayer(player, now)
+			logger.Debug("Player %s respawned at (%.1f, %.1f)", player.UserID, player.X, player.Y)
+		}
+	}
+}
+
+// respawnPlayer places a player at a safe spawn point with full health and spawn protection.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) respawnPlayer(player *PlayerState, now time.Time) {
+	player.X, player.Y = gsm.selectSpawnPoint(player.UserID)
+	player.Health = PlayerMaxHealth
+	player.Eliminated = false
+	player.RespawnAt = time.Time{}
+	gsm.applyStatus(player, StatusSpawnProtection, now, "")
+}
@@ -7,16 +7,16 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
-	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
 	"github.com/sonastea/WizardWarriors/pkg/config"
 	"github.com/sonastea/WizardWarriors/pkg/logger"
-	"google.golang.org/protobuf/proto"
 )
 
-// Redis keys for lobby state
+// Redis keys for lobby state, namespaced per room with roomKey
 const (
 	RedisKeyLobbyUsers = "lobby:users"
 	RedisKeyGameUsers  = "game:users"
+	RedisKeyUsernames  = "lobby:usernames"
+	RedisKeySpectators = "lobby:spectators"
 )
 
 type Hub struct {
@@ -26,14 +26,17 @@ type Hub struct {
 	// users     []models.User
 	clientsMu sync.RWMutex
 	clients   map[*Client]bool
-	// rooms     map[*Room]bool
-	// roomsLive map[string]*Room
-
-	redis            *redis.Client
-	pubsub           *PubSub
-	pubsubEnabled    bool
-	gameStateManager *GameStateManager
-	botManager       *BotManager
+	roomsMu   sync.Mutex
+	rooms     map[string]*Room
+
+	// resumes holds disconnected players by session token during the resume grace period
+	resumesMu sync.Mutex
+	resumes   map[string]*heldSession
+
+	cfg           *config.Config
+	redis         *redis.Client
+	pubsub        *PubSub
+	pubsubEnabled bool
 }
 
 func New(cfg *config.Config) (*Hub, error) {
@@ -47,34 +50,24 @@ func New(cfg *config.Config) (*Hub, error) {
 		unregister: make(chan *Client),
 
 		clients: make(map[*Client]bool),
+		rooms:   make(map[string]*Room),
+		resumes: make(map[string]*heldSession),
 
+		cfg:           cfg,
 		redis:         pubsub.conn,
 		pubsub:        pubsub,
 		pubsubEnabled: !cfg.IsAPIServer,
 	}
 
-	ctx := context.Background()
-
-	hub.redis.Del(ctx, RedisKeyLobbyUsers, RedisKeyGameUsers, "lobby:usernames", RedisKeyBotGame, RedisKeyBotNames)
-
 	if !cfg.IsAPIServer {
-		gameMap, err := LoadMapFromFile(cfg.MapPath)
+		// The default room always exists so bots keep playing with no one connected
+		room, err := newRoom(hub, DefaultRoomID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load game map: %w", err)
+			return nil, err
 		}
+		hub.rooms[DefaultRoomID] = room
 		logger.Info("Loaded game map: %dx%d tiles (%dx%d pixels)",
-			gameMap.Width, gameMap.Height, int(gameMap.PixelWidth), int(gameMap.PixelHeight))
-
-		// Initialize game state manager with 30ms tick rate (33 updates/sec)
-		hub.gameStateManager = NewGameStateManager(hub, gameMap, 30*time.Millisecond)
-
-		// Initialize bot manager and spawn bots
-		hub.botManager = NewBotManager(hub.redis, hub.gameStateManager, gameMap)
-		if err := hub.botManager.Initialize(ctx); err != nil {
-			logger.Error("Failed to initialize bots: %v", err)
-		}
-
-		hub.gameStateManager.Start()
+			room.gameMap.Width, room.gameMap.Height, int(room.gameMap.PixelWidth), int(room.gameMap.PixelHeight))
 	}
 
 	return hub, nil
@@ -104,21 +97,26 @@ func (h *Hub) getTotalClients() int {
 func (hub *Hub) addClient(client *Client) {
 	hub.clientsMu.Lock()
 	hub.clients[client] = true
+	total := hub.getTotalClients()
 	hub.clientsMu.Unlock()
 
-	// Add user to lobby set in Redis
-	ctx := context.Background()
-	if err := hub.redis.SAdd(ctx, RedisKeyLobbyUsers, client.UserID).Err(); err != nil {
-		logger.Error("Failed to add user to lobby in Redis: %v", err)
+	logger.Info("%s (%s) connected - connection pool size: %d", client.Username, client.UserID, total)
+	// A user either plays or spectates in a room, never both, so they cannot watch the
+	// whole map while playing
+	if client.spectator {
+		if client.room.hasPlayer(client.UserID) {
+			client.reject("Already playing in this room")
+			return
+		}
+		client.room.addSpectator(client)
+		return
 	}
-
-	// Store the username mapping in Redis so we can look it up later
-	if err := hub.redis.HSet(ctx, "lobby:usernames", client.UserID, client.Username).Err(); err != nil {
-		logger.Error("Failed to store username in Redis: %v", err)
+	if client.room.hasSpectator(client.UserID) {
+		client.reject("Already spectating this room")
+		return
 	}
-
-	logger.Info("%s (%s) connected - connection pool size: %d", client.Username, client.UserID, hub.getTotalClients())
-	hub.broadcastLobbyState()
+	hub.claimSession(client)
+	client.room.addClient(client)
 }
 
 func (hub *Hub) removeClient(client *Client) {
@@ -129,29 +127,95 @@ func (hub *Hub) removeClient(client *Client) {
 	}
 	hub.clientsMu.Unlock()
 
-	// Remove user from both lobby and game sets in Redis
-	ctx := context.Background()
-	if err := hub.redis.SRem(ctx, RedisKeyLobbyUsers, client.UserID).Err(); err != nil {
-		logger.Error("Failed to remove user from lobby in Redis: %v", err)
+	room := client.room
+	switch {
+	case client.spectator:
+		room.removeSpectator(client)
+		hub.releaseRoom(room)
+
+	case room.hasUser(client.UserID, client):
+		// Already reconnected on another socket, their player belongs to that one now
+		room.detachClient(client)
+		hub.releaseRoom(room)
+
+	case hub.holdSession(client):
+		// Keeps the room reference and Redis membership until resumed or expired
+		room.detachClient(client)
+
+	default:
+		room.gameStateManager.RemovePlayer(client.UserID)
+		room.removeClient(client)
+		hub.releaseRoom(room)
 	}
-	// Remove username mapping
-	if err := hub.redis.HDel(ctx, "lobby:usernames", client.UserID).Err(); err != nil {
-		logger.Error("Failed to remove username from Redis: %v", err)
+}
+
+// acquireRoom returns the room with the given ID, creating it if needed.
+// Every successful call must be paired with releaseRoom.
+func (hub *Hub) acquireRoom(roomID string) (*Room, error) {
+	if roomID == "" {
+		roomID = DefaultRoomID
 	}
-	// Also remove from game users set
-	if err := hub.redis.SRem(ctx, RedisKeyGameUsers, client.UserID).Err(); err != nil {
-		logger.Error("Failed to remove user from game in Redis: %v", err)
+	if !isValidRoomID(roomID) {
+		return nil, fmt.Errorf("invalid room id %q", roomID)
 	}
 
-	hub.broadcastLobbyState()
+	hub.roomsMu.Lock()
+	defer hub.roomsMu.Unlock()
+
+	room, ok := hub.rooms[roomID]
+	if !ok {
+		if len(hub.rooms) >= hub.cfg.MaxRooms {
+			return nil, fmt.Errorf("room limit reached (%d)", hub.cfg.MaxRooms)
+		}
+
+		var err error
+		room, err = newRoom(hub, roomID)
+		if err != nil {
+			return nil, err
+		}
+		hub.rooms[roomID] = room
+	}
+
+	room.refs++
+	return room, nil
+}
+
+// releaseRoom drops a client's hold on a room and closes it once empty.
+// The default room is never closed.
+func (hub *Hub) releaseRoom(room *Room) {
+	hub.roomsMu.Lock()
+	defer hub.roomsMu.Unlock()
+
+	room.refs--
+	if room.refs > 0 || room.ID == DefaultRoomID {
+		return
+	}
+
+	delete(hub.rooms, room.ID)
+	room.close()
 }
 
-func (hub *Hub) broadcastToClients(message []byte) {
-	hub.clientsMu.RLock()
-	defer hub.clientsMu.RUnlock()
-	for client := range hub.clients {
-		client.sendChan <- message
+// getRoom returns a live room hosted by this process
+func (hub *Hub) getRoom(roomID string) (*Room, bool) {
+	hub.roomsMu.Lock()
+	defer hub.roomsMu.Unlock()
+	room, ok := hub.rooms[roomID]
+	return room, ok
+}
+
+// isValidRoomID allows short IDs made of letters, digits, '-' and '_'
+func isValidRoomID(roomID string) bool {
+	if len(roomID) == 0 || len(roomID) > MaxRoomIDLen {
+		return false
 	}
+	for _, r := range roomID {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 // SessionInfo contains user information associated with a game session
@@ -198,132 +262,3 @@ func (hub *Hub) RefreshSession(token string) error {
 
 	return nil
 }
-
-// MoveUserToGame moves a user from the lobby set to the game set in Redis
-func (hub *Hub) MoveUserToGame(userId string) error {
-	ctx := context.Background()
-	pipe := hub.redis.Pipeline()
-	pipe.SRem(ctx, RedisKeyLobbyUsers, userId)
-	pipe.SAdd(ctx, RedisKeyGameUsers, userId)
-	_, err := pipe.Exec(ctx)
-	return err
-}
-
-// MoveUserToLobby moves a user from the game set back to the lobby set in Redis
-func (hub *Hub) MoveUserToLobby(userId string) error {
-	ctx := context.Background()
-	pipe := hub.redis.Pipeline()
-	pipe.SRem(ctx, RedisKeyGameUsers, userId)
-	pipe.SAdd(ctx, RedisKeyLobbyUsers, userId)
-	_, err := pipe.Exec(ctx)
-	return err
-}
-
-// broadcastLobbyState sends the current lobby and game user state to all clients
-func (hub *Hub) broadcastLobbyState() {
-	// Skip if no clients connected
-	hub.clientsMu.RLock()
-	clientCount := len(hub.clients)
-	hub.clientsMu.RUnlock()
-	if clientCount == 0 {
-		return
-	}
-
-	logger.Info("broadcastLobbyState: broadcasting to %d clients (pubsub=%v, botMgr=%v)", clientCount, hub.pubsubEnabled, hub.botManager != nil)
-	ctx := context.Background()
-
-	// Get lobby and game users from Redis
-	lobbyUserIds, err := hub.redis.SMembers(ctx, RedisKeyLobbyUsers).Result()
-	if err != nil {
-		logger.Error("Failed to get lobby users from Redis: %v", err)
-		return
-	}
-
-	gameUserIds, err := hub.redis.SMembers(ctx, RedisKeyGameUsers).Result()
-	if err != nil {
-		logger.Error("Failed to get game users from Redis: %v", err)
-		return
-	}
-
-	// Get username mappings from Redis
-	usernames, err := hub.redis.HGetAll(ctx, "lobby:usernames").Result()
-	if err != nil {
-		logger.Error("Failed to get usernames from Redis: %v", err)
-		usernames = make(map[string]string)
-	}
-
-	// Build lobby user list (humans only)
-	lobbyUsers := make([]*multiplayerv1.LobbyUser, 0, len(lobbyUserIds))
-	for _, id := range lobbyUserIds {
-		username := usernames[id]
-		if username == "" {
-			username = "Unknown"
-		}
-		lobbyUsers = append(lobbyUsers, &multiplayerv1.LobbyUser{
-			UserId:  &multiplayerv1.ID{Value: id},
-			Name:    username,
-			IsReady: false,
-		})
-	}
-
-	// Build game user list (humans + bots)
-	gameUsers := make([]*multiplayerv1.LobbyUser, 0, len(gameUserIds)+BotCount)
-	for _, id := range gameUserIds {
-		username := usernames[id]
-		if username == "" {
-			username = "Unknown"
-		}
-		gameUsers = append(gameUsers, &multiplayerv1.LobbyUser{
-			UserId:  &multiplayerv1.ID{Value: id},
-			Name:    username,
-			IsReady: true,
-		})
-	}
-
-	// Add bots to game user list (read from Redis so both API and game servers see them)
-	botIDs, err := hub.redis.SMembers(ctx, RedisKeyBotGame).Result()
-	if err != nil {
-		logger.Error("Failed to get bot IDs from Redis: %v", err)
-		botIDs = []string{}
-	}
-	botNames, err := hub.redis.HGetAll(ctx, RedisKeyBotNames).Result()
-	if err != nil {
-		logger.Error("Failed to get bot names from Redis: %v", err)
-		botNames = make(map[string]string)
-	}
-	logger.Info("broadcastLobbyState: adding %d bots to game users", len(botIDs))
-	for _, botID := range botIDs {
-		name := botNames[botID]
-		if name == "" {
-			name = "Bot"
-		}
-		gameUsers = append(gameUsers, &multiplayerv1.LobbyUser{
-			UserId:  &multiplayerv1.ID{Value: botID},
-			Name:    name,
-			IsReady: true,
-		})
-	}
-
-	lobbyState := &multiplayerv1.LobbyState{
-		LobbyUsers: lobbyUsers,
-		GameUsers:  gameUsers,
-	}
-
-	logger.Info("broadcastLobbyState: lobbyUsers=%d, gameUsers=%d", len(lobbyUsers), len(gameUsers))
-
-	gameMsg := &multiplayerv1.GameMessage{
-		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_LOBBY_STATE,
-		Payload: &multiplayerv1.GameMessage_LobbyState{
-			LobbyState: lobbyState,
-		},
-	}
-	logger.Debug("broadcastLobbyState: sent %+v", gameMsg)
-
-	wire, err := proto.Marshal(gameMsg)
-	if err != nil {
-		logger.Error("broadcastLobbyState: failed to marshal: %v", err)
-		return
-	}
-
-	hub.broadcastToClients(wire)
-}
@@ -0,0 +1,166 @@
+package hub
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	// MaxActionsPerTick caps the actions one player can queue between two ticks
+	MaxActionsPerTick = 4
+	// inputDropLogInterval throttles the warning for actions over a player's budget
+	inputDropLogInterval = 5 * time.Second
+)
+
+// queuedInput is a player action waiting to be executed after the tick's inputs
+type queuedInput struct {
+	userID string
+	action *multiplayerv1.GameAction
+}
+
+// pendingInputs is what one player has sent since the last tick
+type pendingInputs struct {
+	inputs  []*multiplayerv1.InputAction // Latest state of each key, oldest change first
+	actions []*multiplayerv1.GameAction
+}
+
+// inputQueue buffers each player's inputs and actions between two ticks. Every player
+// gets their own budget so one flooding client cannot crowd out the rest of the room.
+type inputQueue struct {
+	mu       sync.Mutex
+	pending  map[string]*pendingInputs
+	dropped  int       // Actions dropped since the last warning
+	lastWarn time.Time // Wall time of the last warning
+}
+
+func newInputQueue() *inputQueue {
+	return &inputQueue{pending: make(map[string]*pendingInputs)}
+}
+
+// take returns everything queued since the last call and starts a new batch
+func (queue *inputQueue) take() map[string]*pendingInputs {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if len(queue.pending) == 0 {
+		return nil
+	}
+	pending := queue.pending
+	queue.pending = make(map[string]*pendingInputs, len(pending))
+	return pending
+}
+
+// isMoveInput reports whether an input is one of the keys the simulation understands
+func isMoveInput(input multiplayerv1.InputType) bool {
+	switch input {
+	case multiplayerv1.InputType_INPUT_TYPE_MOVE_UP,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_DOWN,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_LEFT,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT:
+		return true
+	}
+	return false
+}
+
+// EnqueuePlayerEvent queues an INPUT or ACTION event for the next tick without blocking.
+// userID must be the authenticated sender. Only the latest state of each key is kept
+// per tick, and actions past MaxActionsPerTick are dropped. Returns false if the event
+// was dropped.
+func (gsm *GameStateManager) EnqueuePlayerEvent(userID string, event *multiplayerv1.PlayerEvent) bool {
+	switch event.GetType() {
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT:
+		if event.InputAction == nil || !isMoveInput(event.InputAction.Input) {
+			return false
+		}
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION:
+		if event.GameAction == nil {
+			logger.Warn("ACTION event from %s missing GameAction", userID)
+			return false
+		}
+	default:
+		return false
+	}
+
+	queue := gsm.inputs
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	pending, ok := queue.pending[userID]
+	if !ok {
+		pending = &pendingInputs{}
+		queue.pending[userID] = pending
+	}
+
+	if input := event.InputAction; input != nil {
+		// A newer state for the same key replaces the old one and moves to the back,
+		// so the last input applied carries the highest sequence
+		pending.inputs = slices.DeleteFunc(pending.inputs, func(queued *multiplayerv1.InputAction) bool {
+			return queued.Input == input.Input
+		})
+		pending.inputs = append(pending.inputs, input)
+		return true
+	}
+
+	if len(pending.actions) >= MaxActionsPerTick {
+		queue.dropped++
+		if now := time.Now(); now.Sub(queue.lastWarn) >= inputDropLogInterval {
+			logger.Warn("[Room %s] Dropped %d actions over the per tick budget, latest from %s", gsm.room.ID, queue.dropped, userID)
+			queue.dropped = 0
+			queue.lastWarn = now
+		}
+		return false
+	}
+	pending.actions = append(pending.actions, event.GameAction)
+	return true
+}
+
+// applyQueuedInputs applies every movement input queued since the last tick
+// and returns the queued actions, which must be executed without holding gsm.mu.
+// Anything queued for a user without a player in the game is discarded.
+func (gsm *GameStateManager) applyQueuedInputs() []queuedInput {
+	pending := gsm.inputs.take()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var actions []queuedInput
+
+	gsm.mu.Lock()
+	for _, userID := range slices.Sorted(maps.Keys(pending)) {
+		player, exists := gsm.players[userID]
+		if !exists {
+			continue
+		}
+		for _, input := range pending[userID].inputs {
+			gsm.record(&multiplayerv1.ReplayEvent{
+				Type:        multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_INPUT,
+				UserId:      userID,
+				InputAction: input,
+			})
+			applyInputAction(player, input)
+		}
+		for _, action := range pending[userID].actions {
+			gsm.record(&multiplayerv1.ReplayEvent{
+				Type:       multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_ACTION,
+				UserId:     userID,
+				GameAction: action,
+			})
+			actions = append(actions, queuedInput{userID: userID, action: action})
+		}
+	}
+	gsm.mu.Unlock()
+
+	return actions
+}
+
+// executePlayerActions runs queued player actions (must be called after releasing gsm.mu)
+func (gsm *GameStateManager) executePlayerActions(actions []queuedInput) {
+	for _, queued := range actions {
+		gsm.room.handleGameAction(queued.userID, queued.action)
+	}
+}
@@ -0,0 +1,137 @@
+package hub
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Compares the direct in-process input path against the previous Redis round trip.
+// The redis case needs a reachable server (REDIS_URL, default localhost) and is skipped otherwise.
+//
+//	go test ./pkg/hub -run '^$' -bench InputPath
+
+const benchUserID = "1"
+
+func newBenchGameState(b *testing.B) *GameStateManager {
+	b.Helper()
+
+	room, _ := newTestRoom(b, newTestSetup(b, "ffa", 1))
+	room.gameStateManager.AddPlayer(benchUserID, "bench")
+	return room.gameStateManager
+}
+
+func benchInputMessage(b *testing.B) []byte {
+	b.Helper()
+
+	wire, err := proto.Marshal(&multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+		Payload: &multiplayerv1.GameMessage_PlayerEvent{
+			PlayerEvent: &multiplayerv1.PlayerEvent{
+				Type: multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT,
+				InputAction: &multiplayerv1.InputAction{
+					Input:   multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT,
+					Pressed: true,
+				},
+			},
+		},
+	})
+	if err != nil {
+		b.Fatalf("failed to marshal input: %v", err)
+	}
+	return wire
+}
+
+func BenchmarkInputPath(b *testing.B) {
+	message := benchInputMessage(b)
+	client := &Client{UserID: benchUserID, Username: "bench"}
+
+	b.Run("direct", func(b *testing.B) {
+		gsm := newBenchGameState(b)
+		b.ReportAllocs()
+		for b.Loop() {
+			gameMsg, err := decodeClientMessage(message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			gsm.room.handlePlayerEvent(client.UserID, gameMsg.GetPlayerEvent())
+			gsm.applyQueuedInputs()
+		}
+	})
+
+	// Envelope encode/decode the Redis path pays on top of the network hop
+	b.Run("envelope", func(b *testing.B) {
+		gsm := newBenchGameState(b)
+		b.ReportAllocs()
+		for b.Loop() {
+			gameMsg, err := decodeClientMessage(message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			wire, err := client.wrapMessage(gameMsg)
+			if err != nil {
+				b.Fatal(err)
+			}
+			envelope := &multiplayerv1.Envelope{}
+			if err := proto.Unmarshal(wire, envelope); err != nil {
+				b.Fatal(err)
+			}
+			gsm.EnqueuePlayerEvent(envelope.GetSenderId().GetValue(), envelope.GetMessage().GetPlayerEvent())
+			gsm.applyQueuedInputs()
+		}
+	})
+
+	b.Run("redis", func(b *testing.B) {
+		redisURL := os.Getenv("REDIS_URL")
+		if redisURL == "" {
+			redisURL = "redis://localhost:6379/0"
+		}
+		opts, err := redis.ParseURL(redisURL)
+		if err != nil {
+			b.Skipf("invalid REDIS_URL: %v", err)
+		}
+
+		ctx := context.Background()
+		rdb := redis.NewClient(opts)
+		defer rdb.Close()
+		if err := rdb.Ping(ctx).Err(); err != nil {
+			b.Skipf("redis unavailable: %v", err)
+		}
+
+		channel := roomChannel("bench", SpaceGame)
+		sub := rdb.Subscribe(ctx, channel)
+		defer sub.Close()
+		if _, err := sub.Receive(ctx); err != nil {
+			b.Fatal(err)
+		}
+		msgs := sub.Channel()
+
+		gsm := newBenchGameState(b)
+		b.ReportAllocs()
+		for b.Loop() {
+			gameMsg, err := decodeClientMessage(message)
+			if err != nil {
+				b.Fatal(err)
+			}
+			wire, err := client.wrapMessage(gameMsg)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := rdb.Publish(ctx, channel, wire).Err(); err != nil {
+				b.Fatal(err)
+			}
+
+			msg := <-msgs
+			envelope := &multiplayerv1.Envelope{}
+			if err := proto.Unmarshal([]byte(msg.Payload), envelope); err != nil {
+				b.Fatal(err)
+			}
+			gsm.EnqueuePlayerEvent(envelope.GetSenderId().GetValue(), envelope.GetMessage().GetPlayerEvent())
+			gsm.applyQueuedInputs()
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package hub
+
+import (
+	"testing"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+)
+
+func TestInputBudgetIsPerPlayer(t *testing.T) {
+	room, _ := newTestRoom(t, newTestSetup(t, "ffa", 1))
+	gsm := room.gameStateManager
+	gsm.AddPlayer("1", "flooder")
+	gsm.AddPlayer("2", "player")
+
+	action := &multiplayerv1.PlayerEvent{
+		Type:       multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION,
+		GameAction: &multiplayerv1.GameAction{Action: multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION},
+	}
+	for seq := range uint32(1000) {
+		gsm.EnqueuePlayerEvent("1", action)
+		gsm.EnqueuePlayerEvent("1", &multiplayerv1.PlayerEvent{
+			Type:        multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT,
+			InputAction: &multiplayerv1.InputAction{Input: multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT, Pressed: seq%2 == 0, Sequence: seq + 1},
+		})
+	}
+	if !gsm.EnqueuePlayerEvent("2", action) {
+		t.Fatal("flooding player crowded out another player's action")
+	}
+	gsm.EnqueuePlayerEvent("3", action) // Not in the game
+
+	counts := make(map[string]int)
+	for _, queued := range gsm.applyQueuedInputs() {
+		counts[queued.userID]++
+	}
+	if counts["1"] != MaxActionsPerTick || counts["2"] != 1 || counts["3"] != 0 {
+		t.Errorf("actions per player = %v, want 1:%d 2:1", counts, MaxActionsPerTick)
+	}
+
+	flooder := gsm.players["1"]
+	if flooder.MoveRight || flooder.LastInputSeq != 1000 {
+		t.Errorf("flooder input = pressed %v seq %d, want the latest state", flooder.MoveRight, flooder.LastInputSeq)
+	}
+}
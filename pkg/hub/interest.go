@@ -0,0 +1,186 @@
+package hub
+
+import (
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+)
+
+const (
+	InterestCellSize float32 = 256 // World pixels covered by one spatial grid cell
+	MinimapInterval          = 500 * time.Millisecond
+)
+
+// SpatialGrid buckets entities by position so radius queries only visit nearby cells
+type SpatialGrid[T any] struct {
+	cellSize float32
+	cols     int
+	rows     int
+	cells    [][]gridEntry[T]
+}
+
+type gridEntry[T any] struct {
+	x, y  float32
+	value T
+}
+
+// NewSpatialGrid covers the map's pixel area with square cells
+func NewSpatialGrid[T any](gameMap *GameMap, cellSize float32) *SpatialGrid[T] {
+	cols := int(gameMap.PixelWidth/cellSize) + 1
+	rows := int(gameMap.PixelHeight/cellSize) + 1
+	return &SpatialGrid[T]{
+		cellSize: cellSize,
+		cols:     cols,
+		rows:     rows,
+		cells:    make([][]gridEntry[T], cols*rows),
+	}
+}
+
+func (grid *SpatialGrid[T]) cellCoords(x, y float32) (int, int) {
+	col := int(x / grid.cellSize)
+	row := int(y / grid.cellSize)
+	return max(0, min(col, grid.cols-1)), max(0, min(row, grid.rows-1))
+}
+
+// Insert adds an entity at a world position
+func (grid *SpatialGrid[T]) Insert(x, y float32, value T) {
+	col, row := grid.cellCoords(x, y)
+	idx := row*grid.cols + col
+	grid.cells[idx] = append(grid.cells[idx], gridEntry[T]{x: x, y: y, value: value})
+}
+
+// Query calls fn for every entity within radius of (x, y)
+func (grid *SpatialGrid[T]) Query(x, y, radius float32, fn func(T)) {
+	minCol, minRow := grid.cellCoords(x-radius, y-radius)
+	maxCol, maxRow := grid.cellCoords(x+radius, y+radius)
+	radiusSq := radius * radius
+
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			for _, entry := range grid.cells[row*grid.cols+col] {
+				dx := entry.x - x
+				dy := entry.y - y
+				if dx*dx+dy*dy <= radiusSq {
+					fn(entry.value)
+				}
+			}
+		}
+	}
+}
+
+// worldView indexes one tick's full GameState so each client can be sent only what it can see
+type worldView struct {
+	state       *multiplayerv1.GameState
+	players     map[string]*multiplayerv1.PlayerState
+	playerGrid  *SpatialGrid[*multiplayerv1.PlayerState]
+	projectiles *SpatialGrid[*multiplayerv1.ProjectileState]
+	items       *SpatialGrid[*multiplayerv1.ItemState]
+	visionScale float32 // Multiplies every client's view radius, lowered by sandstorms
+}
+
+func newWorldView(gameMap *GameMap, state *multiplayerv1.GameState) *worldView {
+	view := &worldView{
+		state:       state,
+		players:     make(map[string]*multiplayerv1.PlayerState, len(state.Players)),
+		playerGrid:  NewSpatialGrid[*multiplayerv1.PlayerState](gameMap, InterestCellSize),
+		projectiles: NewSpatialGrid[*multiplayerv1.ProjectileState](gameMap, InterestCellSize),
+		items:       NewSpatialGrid[*multiplayerv1.ItemState](gameMap, InterestCellSize),
+		visionScale: 1,
+	}
+
+	for _, player := range state.Players {
+		view.players[player.PlayerId.GetValue()] = player
+		if !isInvisible(player) {
+			view.playerGrid.Insert(player.Position.GetX(), player.Position.GetY(), player)
+		}
+	}
+	for _, projectile := range state.Projectiles {
+		view.projectiles.Insert(projectile.Position.GetX(), projectile.Position.GetY(), projectile)
+	}
+	for _, item := range state.Items {
+		view.items.Insert(item.Position.GetX(), item.Position.GetY(), item)
+	}
+	return view
+}
+
+// visibleTo returns the GameState filtered to entities within radius of the viewer.
+// Clients without a player in the game only receive match and map-wide state.
+func (view *worldView) visibleTo(viewerID string, radius float32) *multiplayerv1.GameState {
+	state := &multiplayerv1.GameState{
+		Tick:           view.state.Tick,
+		ServerTimeMs:   view.state.ServerTimeMs,
+		QuicksandEvent: view.state.QuicksandEvent,
+		Match:          view.state.Match,
+		WorldEvents:    view.state.WorldEvents,
+	}
+
+	viewer, ok := view.players[viewerID]
+	if !ok {
+		return state
+	}
+	x, y := viewer.Position.GetX(), viewer.Position.GetY()
+	radius *= view.visionScale
+
+	// Invisible players are left out of the grid but always see themselves
+	if isInvisible(viewer) {
+		state.Players = append(state.Players, viewer)
+	}
+	view.playerGrid.Query(x, y, radius, func(player *multiplayerv1.PlayerState) {
+		state.Players = append(state.Players, player)
+	})
+	view.projectiles.Query(x, y, radius, func(projectile *multiplayerv1.ProjectileState) {
+		state.Projectiles = append(state.Projectiles, projectile)
+	})
+	view.items.Query(x, y, radius, func(item *multiplayerv1.ItemState) {
+		state.Items = append(state.Items, item)
+	})
+	return state
+}
+
+// overview returns the whole world for spectators watching the full map. Invisible
+// players stay hidden so spectating cannot be used to find them.
+func (view *worldView) overview() *multiplayerv1.GameState {
+	state := &multiplayerv1.GameState{
+		Tick:           view.state.Tick,
+		ServerTimeMs:   view.state.ServerTimeMs,
+		QuicksandEvent: view.state.QuicksandEvent,
+		Match:          view.state.Match,
+		WorldEvents:    view.state.WorldEvents,
+		Projectiles:    view.state.Projectiles,
+		Items:          view.state.Items,
+	}
+	for _, player := range view.state.Players {
+		if !isInvisible(player) {
+			state.Players = append(state.Players, player)
+		}
+	}
+	return state
+}
+
+// isInvisible reports whether a player should be hidden from everyone else
+func isInvisible(player *multiplayerv1.PlayerState) bool {
+	for _, effect := range player.Effects {
+		if effect.Type == multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_INVISIBLE {
+			return true
+		}
+	}
+	return false
+}
+
+// buildMinimapState reports every visible player's tile for the minimap. Caller must hold gsm.mu.
+func (gsm *GameStateManager) buildMinimapState() *multiplayerv1.MinimapState {
+	markers := make([]*multiplayerv1.MinimapMarker, 0, len(gsm.players))
+	for _, player := range gsm.players {
+		if player.HasStatus(StatusInvisible) {
+			continue
+		}
+		markers = append(markers, &multiplayerv1.MinimapMarker{
+			PlayerId: &multiplayerv1.ID{Value: player.UserID},
+			Tile: &multiplayerv1.TileCoord{
+				X: int32(player.X) / int32(gsm.gameMap.TileSize),
+				Y: int32(player.Y) / int32(gsm.gameMap.TileSize),
+			},
+		})
+	}
+	return &multiplayerv1.MinimapState{Markers: markers}
+}
@@ -1,7 +1,10 @@
 package hub
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"os"
 	"sync"
 	"time"
 
@@ -10,17 +13,164 @@ import (
 )
 
 const (
-	MaxActiveAloe     = 20
-	AloeSpawnInterval = 2 * time.Second
-	AloePickupRadius  = PlayerRadius
+	ItemPickupRadius     = PlayerRadius
+	InvisibilityDuration = 5 * time.Second
 )
 
 type ItemType int
 
 const (
 	ItemTypeAloe ItemType = iota
+	ItemTypeShield
+	ItemTypePotionRefill
+	ItemTypeSpeedScroll
+	ItemTypeInvisibility
 )
 
+// ItemDef describes what an item type is and what picking it up does
+type ItemDef struct {
+	Name  string // Used in spawn tables and item IDs
+	Proto multiplayerv1.ItemType
+
+	// OnPickup applies the item with gsm.mu held. Returning false leaves the item on the ground.
+	OnPickup func(gsm *GameStateManager, player *PlayerState, now time.Time) bool
+}
+
+var itemDefs = map[ItemType]*ItemDef{
+	ItemTypeAloe: {
+		Name:  "aloe",
+		Proto: multiplayerv1.ItemType_ITEM_TYPE_ALOE,
+		OnPickup: func(gsm *GameStateManager, player *PlayerState, now time.Time) bool {
+			player.AloeCount++
+			gsm.matchManager.RecordAloe(player.UserID)
+			return true
+		},
+	},
+	ItemTypeShield: {
+		Name:  "shield",
+		Proto: multiplayerv1.ItemType_ITEM_TYPE_SHIELD,
+		OnPickup: func(gsm *GameStateManager, player *PlayerState, now time.Time) bool {
+			return gsm.applyStatus(player, StatusShield, now, "")
+		},
+	},
+	ItemTypePotionRefill: {
+		Name:  "potion_refill",
+		Proto: multiplayerv1.ItemType_ITEM_TYPE_POTION_REFILL,
+		OnPickup: func(gsm *GameStateManager, player *PlayerState, now time.Time) bool {
+			player.Cooldowns = nil
+			return true
+		},
+	},
+	ItemTypeSpeedScroll: {
+		Name:  "speed_scroll",
+		Proto: multiplayerv1.ItemType_ITEM_TYPE_SPEED_SCROLL,
+		OnPickup: func(gsm *GameStateManager, player *PlayerState, now time.Time) bool {
+			return gsm.applyStatus(player, StatusSpeedBoost, now, "")
+		},
+	},
+	ItemTypeInvisibility: {
+		Name:  "invisibility",
+		Proto: multiplayerv1.ItemType_ITEM_TYPE_INVISIBILITY,
+		OnPickup: func(gsm *GameStateManager, player *PlayerState, now time.Time) bool {
+			return gsm.applyStatus(player, StatusInvisible, now, "")
+		},
+	},
+}
+
+// parseItemType looks up an item type by its spawn table name
+func parseItemType(name string) (ItemType, bool) {
+	for itemType, def := range itemDefs {
+		if def.Name == name {
+			return itemType, true
+		}
+	}
+	return 0, false
+}
+
+// ItemSpawn is one row of a spawn table
+type ItemSpawn struct {
+	Type           string  `json:"type"`
+	Weight         int     `json:"weight"`          // Relative chance this item is picked for a spawn
+	MaxActive      int     `json:"max_active"`      // Max of this item on the ground at once
+	DespawnSeconds float64 `json:"despawn_seconds"` // Time on the ground before vanishing, 0 keeps it until picked up
+
+	itemType ItemType
+}
+
+// SpawnTable controls which items appear on the map and how often
+type SpawnTable struct {
+	IntervalSeconds float64     `json:"interval_seconds"` // Time between spawn waves
+	MaxActive       int         `json:"max_active"`       // Max items of any type on the ground at once
+	Items           []ItemSpawn `json:"items"`
+}
+
+// DefaultSpawnTable is used when no spawn table file is configured
+func DefaultSpawnTable() *SpawnTable {
+	table := &SpawnTable{
+		IntervalSeconds: 2,
+		MaxActive:       26,
+		Items: []ItemSpawn{
+			{Type: "aloe", Weight: 70, MaxActive: 20},
+			{Type: "shield", Weight: 8, MaxActive: 2, DespawnSeconds: 20},
+			{Type: "potion_refill", Weight: 10, MaxActive: 2, DespawnSeconds: 20},
+			{Type: "speed_scroll", Weight: 8, MaxActive: 1, DespawnSeconds: 20},
+			{Type: "invisibility", Weight: 4, MaxActive: 1, DespawnSeconds: 15},
+		},
+	}
+	if err := table.validate(); err != nil {
+		panic(err)
+	}
+	return table
+}
+
+// LoadSpawnTableFromFile loads an item spawn table from a JSON file
+func LoadSpawnTableFromFile(path string) (*SpawnTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spawn table file: %w", err)
+	}
+
+	return ParseSpawnTable(data)
+}
+
+// ParseSpawnTable parses spawn table JSON data
+func ParseSpawnTable(data []byte) (*SpawnTable, error) {
+	var table SpawnTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse spawn table JSON: %w", err)
+	}
+	if err := table.validate(); err != nil {
+		return nil, err
+	}
+
+	return &table, nil
+}
+
+// validate resolves item type names and rejects tables that could never spawn anything sensible
+func (table *SpawnTable) validate() error {
+	if table.IntervalSeconds <= 0 {
+		return fmt.Errorf("spawn table interval must be positive")
+	}
+
+	for i := range table.Items {
+		entry := &table.Items[i]
+		itemType, ok := parseItemType(entry.Type)
+		if !ok {
+			return fmt.Errorf("unknown item type %q in spawn table", entry.Type)
+		}
+		if entry.Weight < 0 || entry.MaxActive < 0 || entry.DespawnSeconds < 0 {
+			return fmt.Errorf("spawn table entry %q has a negative value", entry.Type)
+		}
+		entry.itemType = itemType
+	}
+
+	return nil
+}
+
+func (table *SpawnTable) interval() time.Duration {
+	return time.Duration(table.IntervalSeconds * float64(time.Second))
+}
+
 type Item struct {
 	ID        string
 	Type      ItemType
@@ -28,30 +178,54 @@ type Item struct {
 	Y         float32
 	Active    bool
 	CreatedAt time.Time
+	ExpiresAt time.Time // Zero if the item never despawns
 }
 
 type ItemManager struct {
-	mu        sync.RWMutex
-	items     map[string]*Item
-	gsm       *GameStateManager
-	idCounter int
-	lastSpawn time.Time
+	mu         sync.RWMutex
+	items      map[string]*Item
+	gsm        *GameStateManager
+	rng        *rand.Rand
+	spawnTable *SpawnTable
+	idCounter  int
+	lastSpawn  time.Time
 }
 
-func NewItemManager(gsm *GameStateManager) *ItemManager {
+func NewItemManager(gsm *GameStateManager, rng *rand.Rand) *ItemManager {
+	table := DefaultSpawnTable()
 	return &ItemManager{
-		items:     make(map[string]*Item),
-		gsm:       gsm,
-		lastSpawn: time.Now().Add(-AloeSpawnInterval),
+		items:      make(map[string]*Item),
+		gsm:        gsm,
+		rng:        rng,
+		spawnTable: table,
+		lastSpawn:  gsm.now().Add(-table.interval()),
 	}
 }
 
+// SetSpawnTable replaces the spawn table, items already on the ground are kept
+func (im *ItemManager) SetSpawnTable(table *SpawnTable) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	im.spawnTable = table
+}
+
 func (im *ItemManager) Update(now time.Time, players map[string]*PlayerState) {
 	im.mu.Lock()
 	defer im.mu.Unlock()
 
-	im.spawnAloe(now)
-	im.handlePickups(players)
+	im.despawnExpired(now)
+	im.spawnItems(now)
+	im.handlePickups(now, players)
+}
+
+// Reset clears all items so the next round starts with a fresh spawn
+func (im *ItemManager) Reset(now time.Time) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	im.items = make(map[string]*Item)
+	im.lastSpawn = now.Add(-im.spawnTable.interval())
 }
 
 func (im *ItemManager) GetActiveItems() []*multiplayerv1.ItemState {
@@ -59,14 +233,14 @@ func (im *ItemManager) GetActiveItems() []*multiplayerv1.ItemState {
 	defer im.mu.RUnlock()
 
 	items := make([]*multiplayerv1.ItemState, 0, len(im.items))
-	for _, item := range im.items {
+	for _, item := range inIDOrder(im.items) {
 		if !item.Active {
 			continue
 		}
 
 		items = append(items, &multiplayerv1.ItemState{
 			ItemId: item.ID,
-			Type:   multiplayerv1.ItemType_ITEM_TYPE_ALOE,
+			Type:   itemDefs[item.Type].Proto,
 			Position: &multiplayerv1.Vector2{
 				X: item.X,
 				Y: item.Y,
@@ -78,48 +252,125 @@ func (im *ItemManager) GetActiveItems() []*multiplayerv1.ItemState {
 	return items
 }
 
-func (im *ItemManager) spawnAloe(now time.Time) {
-	if now.Sub(im.lastSpawn) < AloeSpawnInterval {
+// SpawnItemAt places an item outside the spawn table, returning its ID
+func (im *ItemManager) SpawnItemAt(itemType ItemType, x, y float32, now time.Time) string {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	im.idCounter++
+	itemID := fmt.Sprintf("%s-%d", itemDefs[itemType].Name, im.idCounter)
+	im.items[itemID] = &Item{
+		ID:        itemID,
+		Type:      itemType,
+		X:         x,
+		Y:         y,
+		Active:    true,
+		CreatedAt: now,
+	}
+	return itemID
+}
+
+// RemoveItems takes items off the map, IDs already picked up are ignored
+func (im *ItemManager) RemoveItems(itemIDs []string) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	for _, itemID := range itemIDs {
+		delete(im.items, itemID)
+	}
+}
+
+func (im *ItemManager) despawnExpired(now time.Time) {
+	for itemID, item := range im.items {
+		if !item.ExpiresAt.IsZero() && !now.Before(item.ExpiresAt) {
+			delete(im.items, itemID)
+		}
+	}
+}
+
+// spawnItems tops the map up to the table's limits, picking each item by weight
+func (im *ItemManager) spawnItems(now time.Time) {
+	if now.Sub(im.lastSpawn) < im.spawnTable.interval() {
 		return
 	}
 
 	activeCount := 0
+	activeByType := make(map[ItemType]int)
 	for _, item := range im.items {
 		if item.Active {
 			activeCount++
+			activeByType[item.Type]++
 		}
 	}
 
 	spawnedCount := 0
-	for activeCount < MaxActiveAloe {
-		x, y, ok := im.randomAloePosition()
+	for activeCount < im.spawnTable.MaxActive {
+		entry := im.pickSpawn(activeByType)
+		if entry == nil {
+			break
+		}
+
+		x, y, ok := im.randomItemPosition()
 		if !ok {
 			break
 		}
 
 		im.idCounter++
-		itemID := fmt.Sprintf("aloe-%d", im.idCounter)
-		im.items[itemID] = &Item{
+		itemID := fmt.Sprintf("%s-%d", entry.Type, im.idCounter)
+		item := &Item{
 			ID:        itemID,
-			Type:      ItemTypeAloe,
+			Type:      entry.itemType,
 			X:         x,
 			Y:         y,
 			Active:    true,
 			CreatedAt: now,
 		}
+		if entry.DespawnSeconds > 0 {
+			item.ExpiresAt = now.Add(time.Duration(entry.DespawnSeconds * float64(time.Second)))
+		}
+		im.items[itemID] = item
+
 		activeCount++
+		activeByType[entry.itemType]++
 		spawnedCount++
 	}
 
 	if spawnedCount > 0 {
-		logger.Debug("[ItemManager] Spawned %d aloe, total active: %d", spawnedCount, activeCount)
+		logger.Debug("[ItemManager] Spawned %d items, total active: %d", spawnedCount, activeCount)
 	}
 
 	im.lastSpawn = now
 }
 
-func (im *ItemManager) randomAloePosition() (float32, float32, bool) {
-	tileX, tileY, ok := im.gsm.gameMap.RandomPassableTile()
+// pickSpawn chooses a weighted random entry among those still under their own limit
+func (im *ItemManager) pickSpawn(activeByType map[ItemType]int) *ItemSpawn {
+	totalWeight := 0
+	for i := range im.spawnTable.Items {
+		entry := &im.spawnTable.Items[i]
+		if activeByType[entry.itemType] < entry.MaxActive {
+			totalWeight += entry.Weight
+		}
+	}
+	if totalWeight == 0 {
+		return nil
+	}
+
+	roll := im.rng.Intn(totalWeight)
+	for i := range im.spawnTable.Items {
+		entry := &im.spawnTable.Items[i]
+		if activeByType[entry.itemType] >= entry.MaxActive {
+			continue
+		}
+		if roll < entry.Weight {
+			return entry
+		}
+		roll -= entry.Weight
+	}
+	return nil
+}
+
+func (im *ItemManager) randomItemPosition() (float32, float32, bool) {
+	tileX, tileY, ok := im.gsm.gameMap.RandomPassableTile(im.rng)
 	if !ok {
 		return 0, 0, false
 	}
@@ -130,26 +381,25 @@ func (im *ItemManager) randomAloePosition() (float32, float32, bool) {
 		true
 }
 
-func (im *ItemManager) handlePickups(players map[string]*PlayerState) {
-	pickupRadiusSq := AloePickupRadius * AloePickupRadius
+func (im *ItemManager) handlePickups(now time.Time, players map[string]*PlayerState) {
+	pickupRadiusSq := ItemPickupRadius * ItemPickupRadius
 
-	for itemID, item := range im.items {
+	for _, item := range inIDOrder(im.items) {
 		if !item.Active {
 			continue
 		}
 
-		for _, player := range players {
-			if player.IsFrozen {
+		for _, player := range inIDOrder(players) {
+			if player.IsDisabled() {
 				continue
 			}
 
 			dx := item.X - player.X
 			dy := item.Y - player.Y
 			distanceSq := dx*dx + dy*dy
-			if distanceSq <= pickupRadiusSq {
+			if distanceSq <= pickupRadiusSq && itemDefs[item.Type].OnPickup(im.gsm, player, now) {
 				item.Active = false
-				player.AloeCount++
-				delete(im.items, itemID)
+				delete(im.items, item.ID)
 				break
 			}
 		}
@@ -0,0 +1,279 @@
+package hub
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	KOTHTeams                  = 2
+	KOTHZoneInterval           = 40 * time.Second // How often the zone moves
+	KOTHZoneRadius     float32 = 96               // Radius of random zones when the map defines none
+	KOTHHoldLimit              = 60 * time.Second // Hold time a player needs to win in free-for-all
+	KOTHTeamHoldLimit          = 90 * time.Second // Hold time a team needs to win in team play
+	KOTHZonePrefix             = "hill"           // Map areas whose name starts with this are zone candidates
+	ScorePerHillSecond         = 1
+)
+
+// ControlZone is the area players hold in king of the hill
+type ControlZone struct {
+	Area   MapArea
+	Circle bool
+}
+
+// Contains reports whether a point is inside the zone
+func (zone *ControlZone) Contains(x, y float32) bool {
+	centerX := zone.Area.X + zone.Area.Width/2
+	centerY := zone.Area.Y + zone.Area.Height/2
+	if zone.Circle {
+		dx := (x - centerX) / (zone.Area.Width / 2)
+		dy := (y - centerY) / (zone.Area.Height / 2)
+		return dx*dx+dy*dy <= 1
+	}
+	return x >= zone.Area.X && x <= zone.Area.X+zone.Area.Width &&
+		y >= zone.Area.Y && y <= zone.Area.Y+zone.Area.Height
+}
+
+func (zone *ControlZone) center() (float32, float32) {
+	return zone.Area.X + zone.Area.Width/2, zone.Area.Y + zone.Area.Height/2
+}
+
+// KingOfTheHill scores players for standing unfrozen in a control zone that moves
+// every KOTHZoneInterval. Nobody scores while more than one player, or team in team
+// play, is inside. The first to hold it long enough wins.
+type KingOfTheHill struct {
+	teams      int
+	zone       *ControlZone
+	rotation   *EventScheduler // Moves the zone, runs zoneRotation back to back
+	lastUpdate time.Time
+	held       map[string]time.Duration // Hold time by player ID, or team key in team play
+	contested  bool
+	controller string // Player ID or team key currently scoring
+	ownerTeam  int    // Team currently scoring in team play
+	winnerID   string // Player who held the zone to the limit in free-for-all
+}
+
+const kothRotationKind = "hill"
+
+// zoneRotation moves the zone when the rotation scheduler starts it. It lasts a whole
+// KOTHZoneInterval so the next one starts as it ends.
+type zoneRotation struct {
+	koth *KingOfTheHill
+}
+
+func (*zoneRotation) Kind() multiplayerv1.WorldEventKind {
+	return multiplayerv1.WorldEventKind_WORLD_EVENT_KIND_UNSPECIFIED
+}
+
+func (rotation *zoneRotation) Start(gsm *GameStateManager, now time.Time) bool {
+	rotation.koth.moveZone(gsm)
+	return true
+}
+
+func (*zoneRotation) Update(gsm *GameStateManager, now time.Time)                     {}
+func (*zoneRotation) End(gsm *GameStateManager, now time.Time)                        {}
+func (*zoneRotation) Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent) {}
+
+func NewKingOfTheHill(teams int) *KingOfTheHill {
+	return &KingOfTheHill{
+		teams: teams,
+		held:  make(map[string]time.Duration),
+	}
+}
+
+func (*KingOfTheHill) Type() multiplayerv1.GameMode {
+	return multiplayerv1.GameMode_GAME_MODE_KING_OF_THE_HILL
+}
+
+func (koth *KingOfTheHill) Teams() int { return koth.teams }
+func (*KingOfTheHill) ScoreLimit() int { return 0 }
+func (*KingOfTheHill) Respawns() bool  { return true }
+
+func (*KingOfTheHill) FreezeDuration() time.Duration {
+	return statusEffects[StatusFrozen].Duration
+}
+
+func (koth *KingOfTheHill) holdLimit() time.Duration {
+	if koth.teams > 0 {
+		return KOTHTeamHoldLimit
+	}
+	return KOTHHoldLimit
+}
+
+func (koth *KingOfTheHill) StartRound(gsm *GameStateManager, now time.Time) {
+	gsm.balanceTeams()
+	koth.held = make(map[string]time.Duration)
+	koth.winnerID = ""
+	koth.startRotation(gsm, now)
+}
+
+// startRotation places the zone and schedules it to move every KOTHZoneInterval
+func (koth *KingOfTheHill) startRotation(gsm *GameStateManager, now time.Time) {
+	koth.moveZone(gsm)
+	schedule := &EventSchedule{
+		Events: []EventScheduleEntry{
+			{
+				Kind:            kothRotationKind,
+				IntervalSeconds: KOTHZoneInterval.Seconds(),
+				DurationSeconds: KOTHZoneInterval.Seconds(),
+			},
+		},
+	}
+	kinds := map[string]func() WorldEvent{
+		kothRotationKind: func() WorldEvent { return &zoneRotation{koth: koth} },
+	}
+	koth.rotation = newEventScheduler(schedule, kinds, now)
+}
+
+// side is who a player scores for: their team in team play, themselves otherwise
+func (koth *KingOfTheHill) side(player *PlayerState) string {
+	if koth.teams > 0 {
+		return teamKey(player.Team)
+	}
+	return player.UserID
+}
+
+func teamKey(team int) string {
+	return "team-" + strconv.Itoa(team)
+}
+
+func (koth *KingOfTheHill) Update(gsm *GameStateManager, now time.Time) (int, bool) {
+	elapsed := now.Sub(koth.lastUpdate)
+	if koth.lastUpdate.IsZero() {
+		elapsed = 0
+	}
+	koth.lastUpdate = now
+
+	if koth.rotation == nil {
+		koth.startRotation(gsm, now)
+	}
+	koth.rotation.Update(gsm, now)
+
+	var inside []*PlayerState
+	sides := make(map[string]struct{})
+	for _, player := range inIDOrder(gsm.players) {
+		if player.IsDisabled() || !koth.zone.Contains(player.X, player.Y) {
+			continue
+		}
+		inside = append(inside, player)
+		sides[koth.side(player)] = struct{}{}
+	}
+
+	koth.contested = len(sides) > 1
+	koth.controller = ""
+	koth.ownerTeam = 0
+	if len(sides) != 1 {
+		return 0, false
+	}
+
+	for _, player := range inside {
+		koth.controller = koth.side(player)
+		gsm.matchManager.RecordHillTime(player.UserID, elapsed)
+	}
+	koth.ownerTeam = inside[0].Team
+	koth.held[koth.controller] += elapsed
+
+	if koth.held[koth.controller] < koth.holdLimit() {
+		return 0, false
+	}
+	if koth.teams == 0 {
+		koth.winnerID = koth.controller
+	}
+	return koth.ownerTeam, true
+}
+
+// RoundWinner is the player who held the zone to the limit in free-for-all. Team
+// rounds leave it to the top score on the winning team.
+func (koth *KingOfTheHill) RoundWinner() (string, bool) {
+	return koth.winnerID, koth.winnerID != ""
+}
+
+// moveZone relocates the zone to a map area named hill*, or a random circle when the map has none
+func (koth *KingOfTheHill) moveZone(gsm *GameStateManager) {
+	var candidates []MapArea
+	for _, area := range gsm.gameMap.Areas {
+		if strings.HasPrefix(area.Name, KOTHZonePrefix) && (koth.zone == nil || area.Name != koth.zone.Area.Name) {
+			candidates = append(candidates, area)
+		}
+	}
+
+	if len(candidates) > 0 {
+		area := candidates[gsm.rng.Intn(len(candidates))]
+		koth.zone = &ControlZone{Area: area, Circle: area.Ellipse}
+	} else if tileX, tileY, ok := gsm.gameMap.RandomPassableTile(gsm.rng); ok {
+		half := float32(gsm.gameMap.TileSize) / 2
+		centerX := float32(tileX*gsm.gameMap.TileSize) + half
+		centerY := float32(tileY*gsm.gameMap.TileSize) + half
+		koth.zone = &ControlZone{
+			Area: MapArea{
+				X:      centerX - KOTHZoneRadius,
+				Y:      centerY - KOTHZoneRadius,
+				Width:  KOTHZoneRadius * 2,
+				Height: KOTHZoneRadius * 2,
+			},
+			Circle: true,
+		}
+	} else if koth.zone == nil {
+		koth.zone = &ControlZone{Area: MapArea{Width: gsm.gameMap.PixelWidth, Height: gsm.gameMap.PixelHeight}}
+	}
+
+	x, y := koth.zone.center()
+	logger.Debug("[KOTH] Zone moved to (%.1f, %.1f)", x, y)
+}
+
+func (koth *KingOfTheHill) Reset(gsm *GameStateManager) {
+	koth.zone = nil
+	koth.rotation = nil
+	koth.lastUpdate = time.Time{}
+	koth.held = make(map[string]time.Duration)
+	koth.contested = false
+	koth.controller = ""
+	koth.ownerTeam = 0
+	koth.winnerID = ""
+}
+
+// BotGoal sends every bot to the zone
+func (koth *KingOfTheHill) BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool) {
+	if koth.zone == nil {
+		return BotGoal{}, false
+	}
+	x, y := koth.zone.center()
+	return BotGoal{X: x, Y: y}, true
+}
+
+func (koth *KingOfTheHill) Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time) {
+	for _, team := range state.Teams {
+		team.HillMs = koth.held[teamKey(int(team.Team))].Milliseconds()
+	}
+
+	if koth.zone == nil {
+		return
+	}
+
+	x, y := koth.zone.center()
+	zone := &multiplayerv1.ControlZone{
+		Center:      &multiplayerv1.Vector2{X: x, Y: y},
+		MovesAtMs:   unixMillis(koth.rotation.nextStart(0)),
+		Contested:   koth.contested,
+		HoldLimitMs: koth.holdLimit().Milliseconds(),
+	}
+	if koth.zone.Circle {
+		zone.Shape = multiplayerv1.ZoneShape_ZONE_SHAPE_CIRCLE
+		zone.Radius = koth.zone.Area.Width / 2
+	} else {
+		zone.Shape = multiplayerv1.ZoneShape_ZONE_SHAPE_RECTANGLE
+		zone.Width = koth.zone.Area.Width
+		zone.Height = koth.zone.Area.Height
+	}
+
+	if koth.teams > 0 {
+		zone.ControllerTeam = int32(koth.ownerTeam)
+	} else if koth.controller != "" {
+		zone.ControllerId = &multiplayerv1.ID{Value: koth.controller}
+	}
+	state.Zone = zone
+}
@@ -3,6 +3,7 @@ package hub
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 )
@@ -24,6 +25,18 @@ type TiledLayer struct {
 	Width   int    `json:"width"`
 	Height  int    `json:"height"`
 	Visible bool   `json:"visible"`
+
+	Objects []TiledObject `json:"objects"` // Only set on object layers
+}
+
+// TiledObject is a named point or shape placed on an object layer
+type TiledObject struct {
+	Name    string  `json:"name"`
+	X       float32 `json:"x"` // Pixels from the map's top-left corner
+	Y       float32 `json:"y"`
+	Width   float32 `json:"width"`
+	Height  float32 `json:"height"`
+	Ellipse bool    `json:"ellipse"`
 }
 
 // TileType represents different terrain types for gameplay
@@ -43,6 +56,29 @@ type GameMap struct {
 	PixelWidth  float32      // Total map width in pixels
 	PixelHeight float32      // Total map height in pixels
 	Collision   [][]TileType // 2D grid of tile types [y][x]
+
+	// Markers are named positions from object layers, such as flag bases.
+	// Shapes are stored by their center.
+	Markers map[string]MapPoint
+
+	// Areas are the named rectangles and ellipses from object layers, in map order
+	Areas []MapArea
+}
+
+// MapPoint is a position in world pixels
+type MapPoint struct {
+	X float32
+	Y float32
+}
+
+// MapArea is a shape placed on an object layer, X and Y are its top-left corner
+type MapArea struct {
+	Name    string
+	X       float32
+	Y       float32
+	Width   float32
+	Height  float32
+	Ellipse bool
 }
 
 // Collision tile IDs from the Tiled map
@@ -103,6 +139,7 @@ func ParseTiledMap(data []byte) (*GameMap, error) {
 		PixelWidth:  float32(tiledMap.Width * tiledMap.TileWidth),
 		PixelHeight: float32(tiledMap.Height * tiledMap.TileHeight),
 		Collision:   make([][]TileType, tiledMap.Height),
+		Markers:     make(map[string]MapPoint),
 	}
 
 	// Initialize collision grid with passable tiles
@@ -113,6 +150,25 @@ func ParseTiledMap(data []byte) (*GameMap, error) {
 
 	// Process each layer to build collision data
 	for _, layer := range tiledMap.Layers {
+		if layer.Type == "objectgroup" {
+			for _, object := range layer.Objects {
+				if object.Name == "" {
+					continue
+				}
+				gameMap.Markers[object.Name] = MapPoint{X: object.X + object.Width/2, Y: object.Y + object.Height/2}
+				if object.Width > 0 && object.Height > 0 {
+					gameMap.Areas = append(gameMap.Areas, MapArea{
+						Name:    object.Name,
+						X:       object.X,
+						Y:       object.Y,
+						Width:   object.Width,
+						Height:  object.Height,
+						Ellipse: object.Ellipse,
+					})
+				}
+			}
+			continue
+		}
 		if layer.Type != "tilelayer" {
 			continue
 		}
@@ -203,6 +259,66 @@ func (gm *GameMap) IsCollision(x, y, radius float32) bool {
 	return false
 }
 
+// Raycast walks the tiles a segment from (x0, y0) to (x1, y1) crosses and returns the
+// point where it first enters impassable terrain or leaves the map
+func (gm *GameMap) Raycast(x0, y0, x1, y1 float32) (MapPoint, bool) {
+	size := float64(gm.TileSize)
+	tileX := int(math.Floor(float64(x0) / size))
+	tileY := int(math.Floor(float64(y0) / size))
+	if gm.isBlockedTile(tileX, tileY) {
+		return MapPoint{X: x0, Y: y0}, true
+	}
+
+	dx, dy := float64(x1-x0), float64(y1-y0)
+	stepX, tMaxX, tDeltaX := raySteps(float64(x0), dx, tileX, size)
+	stepY, tMaxY, tDeltaY := raySteps(float64(y0), dy, tileY, size)
+
+	// Step into whichever neighbouring tile the segment reaches first until it ends
+	for {
+		var t float64
+		if tMaxX < tMaxY {
+			t = tMaxX
+			tileX += stepX
+			tMaxX += tDeltaX
+		} else {
+			t = tMaxY
+			tileY += stepY
+			tMaxY += tDeltaY
+		}
+		if t > 1 {
+			return MapPoint{}, false
+		}
+		if gm.isBlockedTile(tileX, tileY) {
+			return MapPoint{X: x0 + float32(dx*t), Y: y0 + float32(dy*t)}, true
+		}
+	}
+}
+
+// raySteps returns the tile step along one axis, the segment fraction at which the first
+// tile boundary is crossed and the fraction between boundaries
+func raySteps(origin, delta float64, tile int, size float64) (int, float64, float64) {
+	switch {
+	case delta > 0:
+		return 1, (float64(tile+1)*size - origin) / delta, size / delta
+	case delta < 0:
+		return -1, (float64(tile)*size - origin) / delta, -size / delta
+	}
+	return 0, math.Inf(1), math.Inf(1)
+}
+
+// HasLineOfSight reports whether a straight line between two points stays clear of impassable terrain
+func (gm *GameMap) HasLineOfSight(x0, y0, x1, y1 float32) bool {
+	_, blocked := gm.Raycast(x0, y0, x1, y1)
+	return !blocked
+}
+
+func (gm *GameMap) isBlockedTile(tileX, tileY int) bool {
+	if tileX < 0 || tileX >= gm.Width || tileY < 0 || tileY >= gm.Height {
+		return true
+	}
+	return gm.Collision[tileY][tileX] == TileTypeImpassable
+}
+
 // IsInSlowdown checks if a point at (x, y) is in a slowdown zone
 func (gm *GameMap) IsInSlowdown(x, y float32) bool {
 	tileType := gm.GetTileType(x, y)
@@ -220,16 +336,44 @@ func (gm *GameMap) IsValidSpawnPoint(x, y, radius float32) bool {
 	return !gm.IsCollision(x, y, radius)
 }
 
-// RandomPassableTile checks if the coords are able to be passed through
-func (gm *GameMap) RandomPassableTile() (int, int, bool) {
+// Marker returns the position of a named marker from the map's object layers
+func (gm *GameMap) Marker(name string) (MapPoint, bool) {
+	point, ok := gm.Markers[name]
+	return point, ok
+}
+
+// NearestPassablePoint returns the center of the passable tile closest to (x, y)
+func (gm *GameMap) NearestPassablePoint(x, y float32) (MapPoint, bool) {
+	tileX := clampInt(int(x)/gm.TileSize, 0, gm.Width-1)
+	tileY := clampInt(int(y)/gm.TileSize, 0, gm.Height-1)
+	half := float32(gm.TileSize) / 2
+
+	for radius := 0; radius < max(gm.Width, gm.Height); radius++ {
+		for dy := -radius; dy <= radius; dy++ {
+			for dx := -radius; dx <= radius; dx++ {
+				nx, ny := tileX+dx, tileY+dy
+				if nx < 0 || nx >= gm.Width || ny < 0 || ny >= gm.Height {
+					continue
+				}
+				if gm.Collision[ny][nx] == TileTypePassable {
+					return MapPoint{X: float32(nx*gm.TileSize) + half, Y: float32(ny*gm.TileSize) + half}, true
+				}
+			}
+		}
+	}
+	return MapPoint{}, false
+}
+
+// RandomPassableTile picks a random passable tile using rng
+func (gm *GameMap) RandomPassableTile(rng *rand.Rand) (int, int, bool) {
 	if gm.Width == 0 || gm.Height == 0 {
 		return 0, 0, false
 	}
 
 	maxAttempts := gm.Width * gm.Height
 	for range maxAttempts {
-		tileX := rand.Intn(gm.Width)
-		tileY := rand.Intn(gm.Height)
+		tileX := rng.Intn(gm.Width)
+		tileY := rng.Intn(gm.Height)
 		if gm.Collision[tileY][tileX] == TileTypePassable {
 			return tileX, tileY, true
 		}
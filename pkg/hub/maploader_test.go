@@ -0,0 +1,44 @@
+package hub
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestShippedMapHasFlagBases(t *testing.T) {
+	room, _ := newTestRoom(t, newTestSetup(t, "ffa", 1))
+	gameMap := room.gameMap
+
+	for team := 1; team <= CTFTeams; team++ {
+		base, ok := gameMap.Marker(fmt.Sprintf("flag_%d", team))
+		if !ok {
+			t.Errorf("map has no flag_%d marker", team)
+			continue
+		}
+		if !gameMap.IsValidSpawnPoint(base.X, base.Y, PlayerRadius) {
+			t.Errorf("flag_%d base (%.0f, %.0f) is not walkable", team, base.X, base.Y)
+		}
+	}
+}
+
+func TestShippedMapHasHills(t *testing.T) {
+	room, _ := newTestRoom(t, newTestSetup(t, "koth", 1))
+	gameMap := room.gameMap
+
+	hills := 0
+	for _, area := range gameMap.Areas {
+		if !strings.HasPrefix(area.Name, KOTHZonePrefix) {
+			continue
+		}
+		hills++
+		zone := &ControlZone{Area: area, Circle: area.Ellipse}
+		if x, y := zone.center(); !gameMap.IsValidSpawnPoint(x, y, PlayerRadius) {
+			t.Errorf("%s center (%.0f, %.0f) is not walkable", area.Name, x, y)
+		}
+	}
+	// The zone never stays put, so it needs somewhere else to move to
+	if hills < 2 {
+		t.Errorf("map has %d %s* areas, want at least 2", hills, KOTHZonePrefix)
+	}
+}
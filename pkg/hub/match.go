@@ -0,0 +1,355 @@
+package hub
+
+import (
+	"sort"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+const (
+	MatchMinHumanPlayers   = 1 // Bots fill the arena, so one human is enough to start
+	MatchCountdownDuration = 5 * time.Second
+	MatchRoundDuration     = 3 * time.Minute
+	MatchResultsDuration   = 10 * time.Second
+	MatchScoreLimit        = 30
+	ScorePerFreeze         = 3
+	ScorePerAloe           = 1
+	ScorePerElimination    = 5
+)
+
+type MatchPhase int
+
+const (
+	MatchPhaseWaiting MatchPhase = iota
+	MatchPhaseCountdown
+	MatchPhaseInProgress
+	MatchPhaseResults
+)
+
+// PlayerScore tracks a player's contribution to the current round
+type PlayerScore struct {
+	UserID        string
+	Username      string
+	Freezes       int
+	AloeCollected int
+	Eliminations  int
+	Thaws         int
+	Captures      int
+	HillTime      time.Duration
+}
+
+func (ps *PlayerScore) Score() int {
+	return ps.Freezes*ScorePerFreeze + ps.AloeCollected*ScorePerAloe + ps.Eliminations*ScorePerElimination +
+		ps.Thaws*ScorePerThaw + ps.Captures*ScorePerCapture + int(ps.HillTime/time.Second)*ScorePerHillSecond
+}
+
+// MatchManager drives the waiting -> countdown -> in-progress -> results -> reset cycle.
+// It has no lock of its own; callers must hold gsm.mu.
+type MatchManager struct {
+	gsm         *GameStateManager
+	phase       MatchPhase
+	round       int
+	phaseEndsAt time.Time
+	scores      map[string]*PlayerScore
+}
+
+func NewMatchManager(gsm *GameStateManager) *MatchManager {
+	return &MatchManager{
+		gsm:    gsm,
+		phase:  MatchPhaseWaiting,
+		scores: make(map[string]*PlayerScore),
+	}
+}
+
+// Update advances the match state machine and returns results when a round just ended
+func (mm *MatchManager) Update(now time.Time) *multiplayerv1.MatchResults {
+	humans := mm.countHumans()
+	winningTeam, won := mm.gsm.mode.Update(mm.gsm, now)
+
+	switch mm.phase {
+	case MatchPhaseWaiting:
+		if humans >= MatchMinHumanPlayers {
+			mm.setPhase(MatchPhaseCountdown, now.Add(MatchCountdownDuration))
+		}
+
+	case MatchPhaseCountdown:
+		if humans < MatchMinHumanPlayers {
+			mm.setPhase(MatchPhaseWaiting, time.Time{})
+			return nil
+		}
+		if !now.Before(mm.phaseEndsAt) {
+			mm.startRound(now)
+		}
+
+	case MatchPhaseInProgress:
+		if humans < MatchMinHumanPlayers {
+			// Everyone left mid-round, nothing to award
+			logger.Info("[Match] Round %d abandoned, no players left", mm.round)
+			mm.gsm.resetRound(now)
+			mm.setPhase(MatchPhaseWaiting, time.Time{})
+			return nil
+		}
+		if won {
+			return mm.endRound(now, multiplayerv1.MatchEndReason_MATCH_END_REASON_OBJECTIVE, winningTeam)
+		}
+		if limit := mm.gsm.mode.ScoreLimit(); limit > 0 && mm.leadingScore() >= limit {
+			return mm.endRound(now, multiplayerv1.MatchEndReason_MATCH_END_REASON_SCORE_LIMIT, mm.leadingTeam())
+		}
+		if !now.Before(mm.phaseEndsAt) {
+			return mm.endRound(now, multiplayerv1.MatchEndReason_MATCH_END_REASON_TIME_LIMIT, mm.leadingTeam())
+		}
+
+	case MatchPhaseResults:
+		if !now.Before(mm.phaseEndsAt) {
+			mm.gsm.resetRound(now)
+			mm.setPhase(MatchPhaseWaiting, time.Time{})
+		}
+	}
+
+	return nil
+}
+
+func (mm *MatchManager) setPhase(phase MatchPhase, endsAt time.Time) {
+	mm.phase = phase
+	mm.phaseEndsAt = endsAt
+	logger.Debug("[Match] Round %d entering phase %d", mm.round, phase)
+}
+
+func (mm *MatchManager) startRound(now time.Time) {
+	mm.round++
+	mm.scores = make(map[string]*PlayerScore)
+	for id, player := range mm.gsm.players {
+		mm.scores[id] = &PlayerScore{UserID: id, Username: player.Username}
+	}
+	mm.gsm.mode.StartRound(mm.gsm, now)
+	mm.setPhase(MatchPhaseInProgress, now.Add(MatchRoundDuration))
+	logger.Info("[Match] Round %d started with %d players", mm.round, len(mm.scores))
+}
+
+func (mm *MatchManager) endRound(now time.Time, reason multiplayerv1.MatchEndReason, winningTeam int) *multiplayerv1.MatchResults {
+	standings := mm.buildScores()
+	results := &multiplayerv1.MatchResults{
+		Round:       int32(mm.round),
+		Standings:   standings,
+		Reason:      reason,
+		WinningTeam: int32(winningTeam),
+	}
+	if len(standings) > 0 && standings[0].Score > 0 {
+		results.WinnerId = standings[0].PlayerId
+	}
+	if picker, ok := mm.gsm.mode.(RoundWinner); ok {
+		if winnerID, ok := picker.RoundWinner(); ok {
+			results.WinnerId = &multiplayerv1.ID{Value: winnerID}
+		}
+	}
+
+	mm.setPhase(MatchPhaseResults, now.Add(MatchResultsDuration))
+	logger.Info("[Match] Round %d ended (%v), winner: %v, winning team: %d",
+		mm.round, reason, results.WinnerId.GetValue(), winningTeam)
+	return results
+}
+
+// IsLive reports whether the round is in progress and scoring
+func (mm *MatchManager) IsLive() bool {
+	return mm.phase == MatchPhaseInProgress
+}
+
+// ActionsAllowed reports whether players may use actions in the current phase
+func (mm *MatchManager) ActionsAllowed() bool {
+	return mm.phase == MatchPhaseWaiting || mm.phase == MatchPhaseInProgress
+}
+
+// RecordFreeze credits a player for freezing an opponent
+func (mm *MatchManager) RecordFreeze(userID string) {
+	if score := mm.liveScore(userID); score != nil {
+		score.Freezes++
+	}
+}
+
+// RecordElimination credits a player for taking an opponent to zero health
+func (mm *MatchManager) RecordElimination(userID string) {
+	if score := mm.liveScore(userID); score != nil {
+		score.Eliminations++
+	}
+}
+
+// RecordThaw credits a player for thawing a frozen teammate
+func (mm *MatchManager) RecordThaw(userID string) {
+	if score := mm.liveScore(userID); score != nil {
+		score.Thaws++
+	}
+}
+
+// RecordCapture credits a player for bringing the enemy flag home
+func (mm *MatchManager) RecordCapture(userID string) {
+	if score := mm.liveScore(userID); score != nil {
+		score.Captures++
+	}
+}
+
+// RecordHillTime credits a player for time spent holding the control zone alone
+func (mm *MatchManager) RecordHillTime(userID string, held time.Duration) {
+	if score := mm.liveScore(userID); score != nil {
+		score.HillTime += held
+	}
+}
+
+// RecordAloe credits a player for picking up an aloe
+func (mm *MatchManager) RecordAloe(userID string) {
+	if score := mm.liveScore(userID); score != nil {
+		score.AloeCollected++
+	}
+}
+
+// AddPlayer registers a late joiner in the current round
+func (mm *MatchManager) AddPlayer(userID, username string) {
+	if mm.phase != MatchPhaseInProgress {
+		return
+	}
+	if _, exists := mm.scores[userID]; !exists {
+		mm.scores[userID] = &PlayerScore{UserID: userID, Username: username}
+	}
+}
+
+func (mm *MatchManager) RemovePlayer(userID string) {
+	delete(mm.scores, userID)
+}
+
+func (mm *MatchManager) liveScore(userID string) *PlayerScore {
+	if !mm.IsLive() {
+		return nil
+	}
+	return mm.scores[userID]
+}
+
+func (mm *MatchManager) leadingScore() int {
+	best := 0
+	for _, score := range mm.scores {
+		if s := score.Score(); s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+// teamScores sums member scores per team, indexed by team number
+func (mm *MatchManager) teamScores() []int {
+	totals := make([]int, mm.gsm.mode.Teams()+1)
+	for id, score := range mm.scores {
+		if player, exists := mm.gsm.players[id]; exists && player.Team > 0 && player.Team < len(totals) {
+			totals[player.Team] += score.Score()
+		}
+	}
+	return totals
+}
+
+// leadingTeam returns the team with the highest total score, 0 on a tie or outside team modes
+func (mm *MatchManager) leadingTeam() int {
+	best, leader := 0, 0
+	for team, total := range mm.teamScores() {
+		if team == 0 {
+			continue
+		}
+		if total > best {
+			best, leader = total, team
+		} else if total == best {
+			leader = 0
+		}
+	}
+	return leader
+}
+
+// buildTeamScores returns per-team totals for team modes, nil in free-for-all
+func (mm *MatchManager) buildTeamScores() []*multiplayerv1.TeamScore {
+	if mm.gsm.mode.Teams() == 0 {
+		return nil
+	}
+
+	totals := mm.teamScores()
+	teams := make([]*multiplayerv1.TeamScore, 0, len(totals)-1)
+	for team := 1; team < len(totals); team++ {
+		teams = append(teams, &multiplayerv1.TeamScore{Team: int32(team), Score: int32(totals[team])})
+	}
+	for _, player := range mm.gsm.players {
+		if player.Team <= 0 || player.Team >= len(totals) {
+			continue
+		}
+		teams[player.Team-1].Players++
+		if player.IsDisabled() {
+			teams[player.Team-1].Disabled++
+		}
+	}
+	return teams
+}
+
+func (mm *MatchManager) countHumans() int {
+	count := 0
+	for id := range mm.gsm.players {
+		if mm.gsm.room.botManager == nil || !mm.gsm.room.botManager.IsBot(id) {
+			count++
+		}
+	}
+	return count
+}
+
+// buildScores returns scores sorted by score, highest first
+func (mm *MatchManager) buildScores() []*multiplayerv1.PlayerScore {
+	scores := make([]*multiplayerv1.PlayerScore, 0, len(mm.scores))
+	for _, score := range mm.scores {
+		scores = append(scores, &multiplayerv1.PlayerScore{
+			PlayerId:      &multiplayerv1.ID{Value: score.UserID},
+			Name:          score.Username,
+			Score:         int32(score.Score()),
+			Freezes:       int32(score.Freezes),
+			AloeCollected: int32(score.AloeCollected),
+			Eliminations:  int32(score.Eliminations),
+			Thaws:         int32(score.Thaws),
+			Captures:      int32(score.Captures),
+			HillMs:        score.HillTime.Milliseconds(),
+		})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].PlayerId.Value < scores[j].PlayerId.Value
+	})
+	return scores
+}
+
+// GetMatchState returns the match progress for broadcasting
+func (mm *MatchManager) GetMatchState(now time.Time) *multiplayerv1.MatchState {
+	var remainingMs int64
+	if !mm.phaseEndsAt.IsZero() && now.Before(mm.phaseEndsAt) {
+		remainingMs = mm.phaseEndsAt.Sub(now).Milliseconds()
+	}
+
+	state := &multiplayerv1.MatchState{
+		Phase:       mm.protoPhase(),
+		Round:       int32(mm.round),
+		RemainingMs: remainingMs,
+		Scores:      mm.buildScores(),
+		ScoreLimit:  int32(mm.gsm.mode.ScoreLimit()),
+		Mode:        mm.gsm.mode.Type(),
+		Teams:       mm.buildTeamScores(),
+	}
+	mm.gsm.mode.Describe(mm.gsm, state, now)
+	return state
+}
+
+func (mm *MatchManager) protoPhase() multiplayerv1.MatchPhase {
+	switch mm.phase {
+	case MatchPhaseWaiting:
+		return multiplayerv1.MatchPhase_MATCH_PHASE_WAITING
+	case MatchPhaseCountdown:
+		return multiplayerv1.MatchPhase_MATCH_PHASE_COUNTDOWN
+	case MatchPhaseInProgress:
+		return multiplayerv1.MatchPhase_MATCH_PHASE_IN_PROGRESS
+	case MatchPhaseResults:
+		return multiplayerv1.MatchPhase_MATCH_PHASE_RESULTS
+	}
+	return multiplayerv1.MatchPhase_MATCH_PHASE_UNSPECIFIED
+}
@@ -0,0 +1,206 @@
+package hub
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+)
+
+// GameMode supplies the rules that differ between modes: teams, how long freezes
+// last, win conditions and what bots go after. Every method runs with gsm.mu held.
+type GameMode interface {
+	Type() multiplayerv1.GameMode
+
+	// Teams is how many teams players are split into, 0 for free-for-all
+	Teams() int
+
+	// ScoreLimit ends the round once a player reaches it, 0 for no limit
+	ScoreLimit() int
+
+	// Respawns reports whether eliminated players come back while a round is live
+	Respawns() bool
+
+	// FreezeDuration is how long a freeze potion keeps a player frozen
+	FreezeDuration() time.Duration
+
+	// StartRound runs when a round goes live
+	StartRound(gsm *GameStateManager, now time.Time)
+
+	// Update runs every tick. It reports a winning team once the mode's win
+	// condition is met, which only ends the round while it is live.
+	Update(gsm *GameStateManager, now time.Time) (winningTeam int, won bool)
+
+	// Reset clears mode state between rounds
+	Reset(gsm *GameStateManager)
+
+	// BotGoal is where a bot should head for the mode's objective
+	BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool)
+
+	// Describe adds mode specific state to the broadcast match state
+	Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time)
+}
+
+// RoundWinner is implemented by modes that decide the winning player themselves
+// instead of leaving it to the top score
+type RoundWinner interface {
+	RoundWinner() (userID string, ok bool)
+}
+
+// FreezeKeeper is implemented by modes whose freezes only end on the mode's terms,
+// like a teammate's thaw in freeze tag. Players cannot spend their way out of those.
+type FreezeKeeper interface {
+	KeepsFreezes() bool
+}
+
+// BotGoal is a position a game mode wants a bot to reach. Bots only go for it when they
+// have no one to chase unless it is urgent.
+type BotGoal struct {
+	X, Y   float32
+	Urgent bool
+}
+
+var gameModes = map[string]func() GameMode{
+	"ffa":           func() GameMode { return &FreeForAll{} },
+	"freeze_tag":    func() GameMode { return NewFreezeTag() },
+	"ctf":           func() GameMode { return NewCaptureTheFlag() },
+	"koth":          func() GameMode { return NewKingOfTheHill(0) },
+	"koth_teams":    func() GameMode { return NewKingOfTheHill(KOTHTeams) },
+	"battle_royale": func() GameMode { return NewBattleRoyale() },
+}
+
+// NewGameMode creates a game mode by its config name
+func NewGameMode(name string) (GameMode, error) {
+	newMode, ok := gameModes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown game mode %q", name)
+	}
+	return newMode(), nil
+}
+
+// FreeForAll is the default mode, every player for themselves up to a score limit
+type FreeForAll struct{}
+
+func (*FreeForAll) Type() multiplayerv1.GameMode {
+	return multiplayerv1.GameMode_GAME_MODE_FREE_FOR_ALL
+}
+
+func (*FreeForAll) Teams() int      { return 0 }
+func (*FreeForAll) ScoreLimit() int { return MatchScoreLimit }
+func (*FreeForAll) Respawns() bool  { return true }
+
+func (*FreeForAll) FreezeDuration() time.Duration {
+	return statusEffects[StatusFrozen].Duration
+}
+
+func (*FreeForAll) StartRound(gsm *GameStateManager, now time.Time) {}
+
+func (*FreeForAll) Update(gsm *GameStateManager, now time.Time) (int, bool) {
+	return 0, false
+}
+
+func (*FreeForAll) Reset(gsm *GameStateManager) {}
+
+func (*FreeForAll) BotGoal(gsm *GameStateManager, botID string) (BotGoal, bool) {
+	return BotGoal{}, false
+}
+
+func (*FreeForAll) Describe(gsm *GameStateManager, state *multiplayerv1.MatchState, now time.Time) {}
+
+// SetMode switches the game mode and reassigns teams to match it
+func (gsm *GameStateManager) SetMode(mode GameMode) {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	gsm.mode = mode
+	for _, player := range gsm.players {
+		player.Team = 0
+	}
+	for _, player := range inIDOrder(gsm.players) {
+		player.Team = gsm.smallestTeam()
+	}
+}
+
+// PlayerTeam returns the team a player is on, 0 if they have none
+func (gsm *GameStateManager) PlayerTeam(userID string) int {
+	gsm.mu.RLock()
+	defer gsm.mu.RUnlock()
+
+	if player, exists := gsm.players[userID]; exists {
+		return player.Team
+	}
+	return 0
+}
+
+// teammates reports whether two players are on the same team
+func teammates(a, b *PlayerState) bool {
+	return a.Team != 0 && a.Team == b.Team
+}
+
+// teamSizes counts the players on each team, indexed by team number
+func (gsm *GameStateManager) teamSizes() []int {
+	sizes := make([]int, gsm.mode.Teams()+1)
+	for _, player := range gsm.players {
+		if player.Team > 0 && player.Team < len(sizes) {
+			sizes[player.Team]++
+		}
+	}
+	return sizes
+}
+
+// smallestTeam picks the team a new player should join, 0 in free-for-all.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) smallestTeam() int {
+	if gsm.mode.Teams() == 0 {
+		return 0
+	}
+
+	sizes := gsm.teamSizes()
+	return slices.Index(sizes[1:], slices.Min(sizes[1:])) + 1
+}
+
+// balanceTeams moves players from the largest team to the smallest until team
+// sizes differ by at most one, preferring to move bots. Caller must hold gsm.mu.
+func (gsm *GameStateManager) balanceTeams() {
+	if gsm.mode.Teams() == 0 {
+		return
+	}
+
+	for {
+		sizes := gsm.teamSizes()
+		smallest := slices.Index(sizes[1:], slices.Min(sizes[1:])) + 1
+		largest := slices.Index(sizes[1:], slices.Max(sizes[1:])) + 1
+		if sizes[largest]-sizes[smallest] <= 1 {
+			return
+		}
+
+		var moved *PlayerState
+		for _, player := range inIDOrder(gsm.players) {
+			if player.Team != largest {
+				continue
+			}
+			moved = player
+			if gsm.room.botManager != nil && gsm.room.botManager.IsBot(player.UserID) {
+				break
+			}
+		}
+		moved.Team = smallest
+	}
+}
+
+// canBreakFreeze reports whether a frozen player may free themselves, e.g. with aloe.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) canBreakFreeze() bool {
+	keeper, ok := gsm.mode.(FreezeKeeper)
+	return !ok || !keeper.KeepsFreezes()
+}
+
+// freezePlayer freezes a player for the mode's freeze duration unless they are on
+// the attacker's team or protected. Caller must hold gsm.mu.
+func (gsm *GameStateManager) freezePlayer(player *PlayerState, now time.Time, attackerID string) bool {
+	if attacker, exists := gsm.players[attackerID]; exists && teammates(attacker, player) {
+		return false
+	}
+	return gsm.applyStatusFor(player, StatusFrozen, now, attackerID, gsm.mode.FreezeDuration())
+}
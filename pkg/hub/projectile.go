@@ -11,11 +11,14 @@ import (
 )
 
 const (
-	FreezePotionSpeed   float32 = 200 // pixels per second
-	FreezePotionRadius  float32 = 64  // splash radius
-	FreezeDuration      float64 = 3.5 // seconds
-	MaxProjectiles      int     = 100
-	ProjectileHitRadius float32 = 20 // collision radius for hitting players
+	FreezePotionSpeed    float32 = 200 // pixels per second
+	FreezePotionRadius   float32 = 64  // splash radius
+	FreezeDuration       float64 = 3.5 // seconds
+	FireballSpeed        float32 = 350 // pixels per second
+	FireballDamage       int     = 25
+	MaxProjectiles       int     = 100
+	ProjectileHitRadius  float32 = 20 // collision radius for hitting players
+	ProjectileWallOffset float32 = 1  // distance kept from a wall a projectile detonates against
 )
 
 type ProjectileType int
@@ -53,8 +56,8 @@ func NewProjectileManager(gsm *GameStateManager) *ProjectileManager {
 	}
 }
 
-// SpawnFreezePotion creates a new freeze potion projectile
-func (pm *ProjectileManager) SpawnFreezePotion(ownerID string, startX, startY, targetX, targetY float32) string {
+// Spawn creates a new projectile of the given type heading for the target
+func (pm *ProjectileManager) Spawn(projectileType ProjectileType, ownerID string, startX, startY, targetX, targetY float32) string {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
@@ -62,10 +65,10 @@ func (pm *ProjectileManager) SpawnFreezePotion(ownerID string, startX, startY, t
 	if len(pm.projectiles) >= MaxProjectiles {
 		var oldestID string
 		var oldestTime time.Time
-		for id, p := range pm.projectiles {
+		for _, p := range inIDOrder(pm.projectiles) {
 			if !p.Active {
 				if oldestID == "" || p.CreatedAt.Before(oldestTime) {
-					oldestID = id
+					oldestID = p.ID
 					oldestTime = p.CreatedAt
 				}
 			}
@@ -75,24 +78,29 @@ func (pm *ProjectileManager) SpawnFreezePotion(ownerID string, startX, startY, t
 		}
 	}
 
+	prefix, speed := "fp", FreezePotionSpeed
+	if projectileType == ProjectileTypeFireball {
+		prefix, speed = "fb", FireballSpeed
+	}
+
 	pm.idCounter++
-	id := fmt.Sprintf("%s-fp-%d", ownerID, pm.idCounter)
+	id := fmt.Sprintf("%s-%s-%d", ownerID, prefix, pm.idCounter)
 
 	projectile := &Projectile{
 		ID:        id,
-		Type:      ProjectileTypeFreezePotion,
+		Type:      projectileType,
 		OwnerID:   ownerID,
 		X:         startX,
 		Y:         startY,
 		TargetX:   targetX,
 		TargetY:   targetY,
-		Speed:     FreezePotionSpeed,
+		Speed:     speed,
 		Active:    true,
-		CreatedAt: time.Now(),
+		CreatedAt: pm.gsm.now(),
 	}
 
 	pm.projectiles[id] = projectile
-	logger.Debug("Spawned freeze potion %s from player %s at (%.1f, %.1f) targeting (%.1f, %.1f)",
+	logger.Debug("Spawned projectile %s from player %s at (%.1f, %.1f) targeting (%.1f, %.1f)",
 		id, ownerID, startX, startY, targetX, targetY)
 
 	return id
@@ -103,7 +111,7 @@ func (pm *ProjectileManager) Update(deltaSeconds float32, players map[string]*Pl
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
-	for _, p := range pm.projectiles {
+	for _, p := range inIDOrder(pm.projectiles) {
 		if !p.Active {
 			continue
 		}
@@ -112,53 +120,73 @@ func (pm *ProjectileManager) Update(deltaSeconds float32, players map[string]*Pl
 		dy := p.TargetY - p.Y
 		distance := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
-		if distance < 10 {
-			p.X = p.TargetX
-			p.Y = p.TargetY
-			pm.detonateProjectile(p, players)
-			continue
+		arrived := distance < 10
+		nextX, nextY := p.TargetX, p.TargetY
+		if !arrived {
+			moveDistance := min(p.Speed*deltaSeconds, distance)
+			nextX = p.X + (dx/distance)*moveDistance
+			nextY = p.Y + (dy/distance)*moveDistance
 		}
 
-		moveDistance := p.Speed * deltaSeconds
-		if moveDistance > distance {
-			moveDistance = distance
+		// Sweep the move so fast projectiles cannot skip over thin walls
+		if impact, blocked := pm.gsm.gameMap.Raycast(p.X, p.Y, nextX, nextY); blocked {
+			p.X, p.Y = impact.X, impact.Y
+			if distance > 0 {
+				// Back off so the splash is measured from open ground, not inside the wall
+				p.X -= dx / distance * ProjectileWallOffset
+				p.Y -= dy / distance * ProjectileWallOffset
+			}
+			pm.detonateProjectile(p, nil, players)
+			continue
 		}
 
-		p.X += (dx / distance) * moveDistance
-		p.Y += (dy / distance) * moveDistance
+		p.X, p.Y = nextX, nextY
+		if arrived {
+			pm.detonateProjectile(p, nil, players)
+			continue
+		}
 
-		if pm.checkPlayerCollision(p, players) {
-			pm.detonateProjectile(p, players)
+		if hit := pm.checkPlayerCollision(p, players); hit != nil {
+			pm.detonateProjectile(p, hit, players)
 		}
 	}
 }
 
-// checkPlayerCollision checks if projectile hit any player (except owner)
-func (pm *ProjectileManager) checkPlayerCollision(p *Projectile, players map[string]*PlayerState) bool {
-	for _, player := range players {
+// checkPlayerCollision returns the player the projectile hit, if any (never the owner)
+func (pm *ProjectileManager) checkPlayerCollision(p *Projectile, players map[string]*PlayerState) *PlayerState {
+	for _, player := range inIDOrder(players) {
 		if player.UserID == p.OwnerID {
 			continue // Don't hit self
 		}
 
+		if player.Eliminated {
+			continue
+		}
+
 		dx := p.X - player.X
 		dy := p.Y - player.Y
 		distSq := dx*dx + dy*dy
 
 		if distSq < ProjectileHitRadius*ProjectileHitRadius {
-			return true
+			return player
 		}
 	}
 
-	return false
+	return nil
 }
 
-// detonateProjectile handles projectile impact (freeze players in radius)
-func (pm *ProjectileManager) detonateProjectile(p *Projectile, players map[string]*PlayerState) {
-	p.Active = false
+// detonateProjectile handles projectile impact: potions freeze players in radius,
+// fireballs damage the player they hit and fizzle otherwise
+func (pm *ProjectileManager) detonateProjectile(p *Projectile, hit *PlayerState, players map[string]*PlayerState) {
 	p.Active = false
 
-	if p.Type == ProjectileTypeFreezePotion {
+	switch p.Type {
+	case ProjectileTypeFreezePotion:
 		pm.freezePlayersInRadius(p.X, p.Y, FreezePotionRadius, p.OwnerID, players)
+	case ProjectileTypeFireball:
+		if hit != nil {
+			pm.gsm.damagePlayer(hit, FireballDamage, p.OwnerID)
+		}
 	}
 
 	logger.Debug("Projectile %s detonated at (%.1f, %.1f)", p.ID, p.X, p.Y)
@@ -167,19 +195,19 @@ func (pm *ProjectileManager) detonateProjectile(p *Projectile, players map[strin
 // freezePlayersInRadius freezes all players within radius
 func (pm *ProjectileManager) freezePlayersInRadius(x, y, radius float32, excludeOwner string, players map[string]*PlayerState) {
 	radiusSq := radius * radius
-	now := time.Now()
+	now := pm.gsm.now()
 
-	for _, player := range players {
+	for _, player := range inIDOrder(players) {
 		if player.UserID == excludeOwner {
 			continue // Don't freeze self
 		}
 
-		if player.IsFrozen {
+		if player.Eliminated {
 			continue
 		}
 
-		if now.Before(player.FreezeImmunity) {
-			logger.Debug("Player %s has freeze immunity, skipping", player.UserID)
+		// Walls shield players from the splash
+		if !pm.gsm.gameMap.HasLineOfSight(x, y, player.X, player.Y) {
 			continue
 		}
 
@@ -188,16 +216,35 @@ func (pm *ProjectileManager) freezePlayersInRadius(x, y, radius float32, exclude
 		distSq := dx*dx + dy*dy
 
 		if distSq <= radiusSq {
-			player.IsFrozen = true
-			player.FrozenUntil = now.Add(time.Duration(FreezeDuration * float64(time.Second)))
-			player.AloeCount = 0
-			player.SpeedBoostUntil = time.Time{}
+			// Already frozen or protected by immunity/spawn protection
+			if !pm.gsm.freezePlayer(player, now, excludeOwner) {
+				continue
+			}
+			pm.gsm.matchManager.RecordFreeze(excludeOwner)
 
 			logger.Info("Player %s frozen by freeze potion", player.UserID)
 		}
 	}
 }
 
+// NearestActiveDistSq returns the squared distance from (x, y) to the closest
+// in-flight projectile, or the largest float32 if there are none
+func (pm *ProjectileManager) NearestActiveDistSq(x, y float32) float32 {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	nearest := float32(math.MaxFloat32)
+	for _, p := range pm.projectiles {
+		if !p.Active {
+			continue
+		}
+		dx := p.X - x
+		dy := p.Y - y
+		nearest = min(nearest, dx*dx+dy*dy)
+	}
+	return nearest
+}
+
 // GetActiveProjectiles returns all projectiles for broadcasting
 func (pm *ProjectileManager) GetActiveProjectiles() []*multiplayerv1.ProjectileState {
 	pm.mu.RLock()
@@ -227,12 +274,20 @@ func (pm *ProjectileManager) GetActiveProjectiles() []*multiplayerv1.ProjectileS
 	return states
 }
 
+// Reset removes every projectile, used between rounds
+func (pm *ProjectileManager) Reset() {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.projectiles = make(map[string]*Projectile)
+}
+
 // CleanupInactiveProjectiles removes old inactive projectiles
 func (pm *ProjectileManager) CleanupInactiveProjectiles() {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
-	cutoff := time.Now().Add(-5 * time.Second) // Remove after 5 seconds
+	cutoff := pm.gsm.now().Add(-5 * time.Second) // Remove after 5 seconds
 
 	for id, p := range pm.projectiles {
 		if !p.Active && p.CreatedAt.Before(cutoff) {
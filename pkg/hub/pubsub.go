@@ -3,6 +3,7 @@ package hub
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/redis/go-redis/v9"
 	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
@@ -13,6 +14,11 @@ import (
 
 type Space string
 
+const (
+	SpaceLobby Space = "chat.lobby"
+	SpaceGame  Space = "chat.game"
+)
+
 type PubSub struct {
 	conn          *redis.Client
 	subs          []Space
@@ -26,8 +32,8 @@ func NewPubSub(cfg *config.Config) (*PubSub, error) {
 	}
 
 	subs := []Space{
-		"chat.lobby",
-		"chat.game",
+		SpaceLobby,
+		SpaceGame,
 	}
 
 	pubsub := &PubSub{
@@ -41,7 +47,8 @@ func NewPubSub(cfg *config.Config) (*PubSub, error) {
 
 func (hub *Hub) ListenPubSub(ctx context.Context) {
 	for _, sub := range hub.pubsub.subs {
-		ch := hub.pubsub.conn.PSubscribe(ctx, string(sub))
+		// Subscribe to the space across every room, e.g. room:*:chat.lobby
+		ch := hub.pubsub.conn.PSubscribe(ctx, roomChannel("*", sub))
 		hub.pubsub.subscriptions[sub] = ch
 	}
 
@@ -57,134 +64,173 @@ func (hub *Hub) ListenPubSub(ctx context.Context) {
 						return
 					}
 
-					gameMsg := &multiplayerv1.GameMessage{}
-					if err := proto.Unmarshal([]byte(msg.Payload), gameMsg); err != nil {
-						logger.Error("Failed to unmarshal GameMessage: %v", err)
+					roomID, ok := parseRoomChannel(msg.Channel, space)
+					if !ok {
+						logger.Warn("Ignoring message on unexpected channel %s", msg.Channel)
 						continue
 					}
 
-					switch gameMsg.Type {
-					case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE:
-						if chatMsg := gameMsg.GetChatMessage(); chatMsg != nil {
-							logger.Info("Chat from %v: %s", chatMsg.SenderId, chatMsg.Text)
-							wire, _ := toWire(gameMsg)
-							hub.broadcastToClients(wire)
-						}
-
-					case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT:
-						if playerEvent := gameMsg.GetPlayerEvent(); playerEvent != nil {
-							logger.Debug("Player event: %v for player %v", playerEvent.Type, playerEvent.PlayerId)
-
-							switch playerEvent.Type {
-							case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_JOIN:
-								if playerEvent.PlayerId != nil {
-									// Look up the username from Redis using the user's ID
-									userID := playerEvent.PlayerId.Value
-									username, err := hub.redis.HGet(context.Background(), "lobby:usernames", userID).Result()
-									if err != nil || username == "" {
-										username = "Unknown"
-									}
-
-									// Server generates spawn position (ignores client suggestion)
-									hub.gameStateManager.AddPlayer(
-										userID,
-										username,
-									)
-
-									// Move user from lobby to game in Redis
-									if err := hub.MoveUserToGame(userID); err != nil {
-										logger.Error("Failed to move user to game in Redis: %v", err)
-									}
-
-									// Get the server-assigned position to send back
-									x, y, _ := hub.gameStateManager.GetPlayerPosition(userID)
-
-									// Create a new join event with server-assigned position
-									joinMsg := &multiplayerv1.GameMessage{
-										Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
-										Payload: &multiplayerv1.GameMessage_PlayerEvent{
-											PlayerEvent: &multiplayerv1.PlayerEvent{
-												Type:     multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_JOIN,
-												PlayerId: playerEvent.PlayerId,
-												Position: &multiplayerv1.Vector2{X: x, Y: y},
-											},
-										},
-									}
-
-									wire, _ := toWire(joinMsg)
-									hub.broadcastToClients(wire)
-
-									// Broadcast updated lobby state
-									hub.broadcastLobbyState()
-								}
-
-							case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT:
-								// Client sends single input change (key press/release)
-								if playerEvent.PlayerId != nil && playerEvent.InputAction != nil {
-									hub.gameStateManager.UpdatePlayerInputAction(
-										playerEvent.PlayerId.Value,
-										playerEvent.InputAction,
-									)
-								}
-
-							case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_MOVE:
-								// Deprecated: ignore position updates from clients
-								// Server is authoritative - only INPUT events affect movement
-								logger.Warn("Ignoring deprecated MOVE event from %v", playerEvent.PlayerId)
-
-							case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_LEAVE:
-								if playerEvent.PlayerId != nil {
-									hub.gameStateManager.RemovePlayer(playerEvent.PlayerId.Value)
-
-									// Remove user from game set in Redis (they'll be removed from lobby on disconnect)
-									if err := hub.redis.SRem(context.Background(), RedisKeyGameUsers, playerEvent.PlayerId.Value).Err(); err != nil {
-										logger.Error("Failed to remove user from game in Redis: %v", err)
-									}
-
-									wire, _ := toWire(gameMsg)
-									hub.broadcastToClients(wire)
-
-									// Broadcast updated lobby state
-									hub.broadcastLobbyState()
-								}
-
-							case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION:
-								// Handle game actions (fire, abilities, etc.)
-								logger.Info("Received ACTION event from player %v", playerEvent.PlayerId)
-								if playerEvent.PlayerId != nil && playerEvent.GameAction != nil {
-									logger.Info("Processing game action: %v with target (%.1f, %.1f)",
-										playerEvent.GameAction.Action,
-										playerEvent.GameAction.Target.GetX(),
-										playerEvent.GameAction.Target.GetY())
-									hub.handleGameAction(
-										playerEvent.PlayerId.Value,
-										playerEvent.GameAction,
-									)
-								} else {
-									logger.Warn("ACTION event missing PlayerId or GameAction")
-								}
-							}
-						}
-
-					case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE:
-						if gameState := gameMsg.GetGameState(); gameState != nil {
-							logger.Debug("Game state update with %d players", len(gameState.Players))
-						}
-
-					case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_ANNOUNCEMENT:
-						if announcement := gameMsg.GetChatAnnouncement(); announcement != nil {
-							logger.Info("Announcement: %s", announcement.Text)
-						}
-
-					default:
-						logger.Warn("Unknown message type: %v", gameMsg.Type)
+					// Rooms hosted by other game servers are not our concern
+					room, ok := hub.getRoom(roomID)
+					if !ok {
+						continue
 					}
+
+					envelope := &multiplayerv1.Envelope{}
+					if err := proto.Unmarshal([]byte(msg.Payload), envelope); err != nil {
+						logger.Error("Failed to unmarshal Envelope: %v", err)
+						continue
+					}
+
+					room.handleEnvelope(envelope)
 				}
 			}
 		}(chName, pubsubCh)
 	}
 }
 
+// parseRoomChannel extracts the room ID from a channel like room:<id>:chat.lobby
+func parseRoomChannel(channel string, space Space) (string, bool) {
+	rest, ok := strings.CutPrefix(channel, "room:")
+	if !ok {
+		return "", false
+	}
+	roomID, ok := strings.CutSuffix(rest, ":"+string(space))
+	if !ok || roomID == "" {
+		return "", false
+	}
+	return roomID, true
+}
+
+// handleEnvelope dispatches a message received on one of the room's pub/sub channels.
+// The envelope's sender is the only identity trusted for the wrapped message.
+func (room *Room) handleEnvelope(envelope *multiplayerv1.Envelope) {
+	senderID := envelope.GetSenderId().GetValue()
+	gameMsg := envelope.GetMessage()
+	if senderID == "" || gameMsg == nil {
+		logger.Warn("Dropping envelope without sender or message")
+		return
+	}
+	sender := &multiplayerv1.ID{Value: senderID}
+
+	switch gameMsg.Type {
+	case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_CHAT_MESSAGE:
+		if chatMsg := gameMsg.GetChatMessage(); chatMsg != nil {
+			chatMsg.SenderId = sender
+			chatMsg.SenderName = envelope.SenderName
+
+			logger.Info("Chat from %v: %s", chatMsg.SenderId, chatMsg.Text)
+			wire, _ := toWire(gameMsg)
+			room.broadcastToClients(wire)
+		}
+
+	case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT:
+		if playerEvent := gameMsg.GetPlayerEvent(); playerEvent != nil {
+			room.handlePlayerEvent(senderID, playerEvent)
+		}
+
+	case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE:
+		if gameState := gameMsg.GetGameState(); gameState != nil {
+			logger.Debug("Game state update with %d players", len(gameState.Players))
+		}
+
+	case multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_ANNOUNCEMENT:
+		if announcement := gameMsg.GetChatAnnouncement(); announcement != nil {
+			logger.Info("Announcement: %s", announcement.Text)
+		}
+
+	default:
+		logger.Warn("Unknown message type: %v", gameMsg.Type)
+	}
+}
+
+// handlePlayerEvent applies a player event from an authenticated sender. Local clients
+// call it straight from their read loop, so a JOIN is handled before any input after it.
+func (room *Room) handlePlayerEvent(senderID string, playerEvent *multiplayerv1.PlayerEvent) {
+	logger.Debug("Player event: %v for player %v", playerEvent.Type, senderID)
+
+	if !verifySender(playerEvent, senderID) {
+		return
+	}
+	playerEvent.PlayerId = &multiplayerv1.ID{Value: senderID}
+
+	switch playerEvent.Type {
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_JOIN:
+		// Look up the username from Redis using the sender's ID
+		username := room.getUsername(senderID)
+
+		// Server generates spawn position (ignores client suggestion)
+		room.gameStateManager.AddPlayer(
+			senderID,
+			username,
+		)
+
+		// Move user from lobby to game in Redis
+		if err := room.MoveUserToGame(senderID); err != nil {
+			logger.Error("Failed to move user to game in Redis: %v", err)
+		}
+
+		// Joining players start from a full snapshot
+		room.resetSnapshots(senderID)
+
+		// Get the server-assigned position to send back
+		x, y, _ := room.gameStateManager.GetPlayerPosition(senderID)
+
+		// Create a new join event with server-assigned position
+		joinMsg := &multiplayerv1.GameMessage{
+			Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+			Payload: &multiplayerv1.GameMessage_PlayerEvent{
+				PlayerEvent: &multiplayerv1.PlayerEvent{
+					Type:     multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_JOIN,
+					PlayerId: playerEvent.PlayerId,
+					Position: &multiplayerv1.Vector2{X: x, Y: y},
+				},
+			},
+		}
+
+		wire, _ := toWire(joinMsg)
+		room.broadcastToClients(wire)
+
+		// Broadcast updated lobby state
+		room.broadcastLobbyState()
+
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT,
+		multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION:
+		room.gameStateManager.EnqueuePlayerEvent(senderID, playerEvent)
+
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_MOVE:
+		// Deprecated: ignore position updates from clients
+		// Server is authoritative - only INPUT events affect movement
+		logger.Warn("Ignoring deprecated MOVE event from %v", senderID)
+
+	case multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_LEAVE:
+		room.gameStateManager.RemovePlayer(senderID)
+
+		// Remove user from game set in Redis (they'll be removed from lobby on disconnect)
+		if err := room.hub.redis.SRem(context.Background(), room.keys.GameUsers, senderID).Err(); err != nil {
+			logger.Error("Failed to remove user from game in Redis: %v", err)
+		}
+
+		wire, _ := toWire(&multiplayerv1.GameMessage{
+			Type:    multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+			Payload: &multiplayerv1.GameMessage_PlayerEvent{PlayerEvent: playerEvent},
+		})
+		room.broadcastToClients(wire)
+
+		// Broadcast updated lobby state
+		room.broadcastLobbyState()
+	}
+}
+
+// verifySender rejects player events that claim to come from someone other than the sender
+func verifySender(event *multiplayerv1.PlayerEvent, senderID string) bool {
+	if claimed := event.GetPlayerId().GetValue(); claimed != "" && claimed != senderID {
+		logger.Warn("Rejecting %v event from %s claiming to be %s", event.Type, senderID, claimed)
+		return false
+	}
+	return true
+}
+
 func toWire(m *multiplayerv1.GameMessage) ([]byte, error) {
 	wire, err := proto.Marshal(m)
 	if err != nil {
@@ -194,30 +240,28 @@ func toWire(m *multiplayerv1.GameMessage) ([]byte, error) {
 	return wire, nil
 }
 
-// handleGameAction processes game actions like throwing potions
-func (hub *Hub) handleGameAction(playerID string, action *multiplayerv1.GameAction) {
+// handleGameAction processes game actions, anything in the ability registry is cast through it
+func (room *Room) handleGameAction(playerID string, action *multiplayerv1.GameAction) {
 	if action == nil {
 		return
 	}
 
-	if hub.gameStateManager.IsPlayerFrozen(playerID) {
-		logger.Debug("Player %s tried to perform action while frozen, ignoring", playerID)
+	logger.Debug("Processing game action %v from player %s", action.Action, playerID)
+
+	if _, ok := lookupAbility(action.Action); ok {
+		if room.gameStateManager.UseAbility(playerID, action.Action, action.Target.GetX(), action.Target.GetY()) {
+			logger.Debug("Player %s used %v toward (%.1f, %.1f)",
+				playerID, action.Action, action.Target.GetX(), action.Target.GetY())
+		}
 		return
 	}
 
 	switch action.Action {
-	case multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION:
-		hub.gameStateManager.SpawnFreezePotion(
-			playerID,
-			action.Target.X,
-			action.Target.Y,
-		)
-		logger.Debug("Player %s threw potion toward (%.1f, %.1f)",
-			playerID, action.Target.X, action.Target.Y)
-
 	case multiplayerv1.ActionType_ACTION_TYPE_INTERACT:
-		// TODO: Implement interact action
-		logger.Debug("Player %s used interact (not implemented)", playerID)
+		// Aloe can still be spent while frozen to break free, so it does its own checks
+		if !room.gameStateManager.ConsumeAloe(playerID, action.AloeUse) {
+			logger.Debug("Player %s could not spend aloe on %v", playerID, action.AloeUse)
+		}
 
 	default:
 		logger.Warn("Unknown action type: %v from player %s", action.Action, playerID)
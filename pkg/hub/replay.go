@@ -0,0 +1,304 @@
+package hub
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// ReplayFileExt is the extension of recorded replay files
+const ReplayFileExt = ".wwreplay"
+
+// ReplayRecorder writes a room's replay as length-delimited protobuf. Writes are
+// buffered and flushed when the recording stops.
+type ReplayRecorder struct {
+	mu      sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	stopped bool
+}
+
+// NewReplayRecorder creates the replay file at path and writes its header. An existing
+// file is never overwritten.
+func NewReplayRecorder(path string, header *multiplayerv1.ReplayHeader) (*ReplayRecorder, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replay file: %w", err)
+	}
+
+	rec := &ReplayRecorder{file: file, writer: bufio.NewWriter(file)}
+	if _, err := protodelim.MarshalTo(rec.writer, header); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to write replay header: %w", err)
+	}
+	return rec, nil
+}
+
+// Record appends an event. A failed write stops the recording, not the room.
+func (rec *ReplayRecorder) Record(event *multiplayerv1.ReplayEvent) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.stopped {
+		return
+	}
+	if _, err := protodelim.MarshalTo(rec.writer, event); err != nil {
+		logger.Error("[Replay] Failed to write to %s, recording stopped: %v", rec.file.Name(), err)
+		rec.stopped = true
+	}
+}
+
+// Close flushes the recording and closes the file
+func (rec *ReplayRecorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	rec.stopped = true
+	err := rec.writer.Flush()
+	if closeErr := rec.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// startRecording records the room to a new file in dir, if one is set.
+// Failing to record is logged and the room runs without a replay.
+func (room *Room) startRecording(dir string, setup *SimulationSetup) {
+	if dir == "" {
+		return
+	}
+
+	gsm := room.gameStateManager
+	header := &multiplayerv1.ReplayHeader{
+		RoomId:                 room.ID,
+		Seed:                   gsm.seed,
+		MapPath:                setup.MapPath,
+		GameMode:               setup.GameMode,
+		ItemSpawnTable:         setup.ItemSpawnTablePath,
+		WorldEventSchedule:     setup.WorldEventSchedulePath,
+		TickRateNs:             int64(gsm.tickRate),
+		StartedAtNs:            gsm.simTime.UnixNano(),
+		MapData:                setup.MapData,
+		ItemSpawnTableData:     setup.ItemSpawnTableData,
+		WorldEventScheduleData: setup.WorldEventScheduleData,
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		logger.Error("[Room %s] Not recording a replay: %v", room.ID, err)
+		return
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%d%s", room.ID, gsm.simTime.UnixNano(), ReplayFileExt))
+	rec, err := NewReplayRecorder(path, header)
+	if err != nil {
+		logger.Error("[Room %s] Not recording a replay: %v", room.ID, err)
+		return
+	}
+
+	gsm.mu.Lock()
+	gsm.recorder = rec
+	gsm.mu.Unlock()
+	logger.Info("[Room %s] Recording replay to %s", room.ID, path)
+}
+
+// record stamps an event with the current tick and appends it to the room's replay,
+// if one is being recorded. Caller must hold gsm.mu.
+func (gsm *GameStateManager) record(event *multiplayerv1.ReplayEvent) {
+	if gsm.recorder == nil {
+		return
+	}
+	event.Tick = gsm.tickNumber
+	gsm.recorder.Record(event)
+}
+
+// stopRecording ends the room's replay once the simulation has stopped
+func (gsm *GameStateManager) stopRecording() {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	if gsm.recorder == nil {
+		return
+	}
+	gsm.record(&multiplayerv1.ReplayEvent{Type: multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_END})
+	if err := gsm.recorder.Close(); err != nil {
+		logger.Error("[Room %s] Failed to finish replay: %v", gsm.room.ID, err)
+	}
+	gsm.recorder = nil
+}
+
+// Replay is a recorded match loaded from a replay file
+type Replay struct {
+	Header *multiplayerv1.ReplayHeader
+	Events []*multiplayerv1.ReplayEvent
+}
+
+// LoadReplayFromFile reads a replay file. A recording cut off mid-event, as happens
+// when the server dies, is loaded up to the last complete event.
+func LoadReplayFromFile(path string) (*Replay, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open replay file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	header := &multiplayerv1.ReplayHeader{}
+	if err := protodelim.UnmarshalFrom(reader, header); err != nil {
+		return nil, fmt.Errorf("failed to read replay header: %w", err)
+	}
+
+	replay := &Replay{Header: header}
+	for {
+		event := &multiplayerv1.ReplayEvent{}
+		err := protodelim.UnmarshalFrom(reader, event)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			logger.Warn("[Replay] %s is truncated, playing its first %d events", path, len(replay.Events))
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read replay event %d: %w", len(replay.Events), err)
+		}
+		replay.Events = append(replay.Events, event)
+	}
+
+	return replay, nil
+}
+
+// TickRate is the length of one simulation step in the recording
+func (replay *Replay) TickRate() time.Duration {
+	return time.Duration(replay.Header.TickRateNs)
+}
+
+// LastTick is the number of steps the recorded room ran
+func (replay *Replay) LastTick() int64 {
+	if len(replay.Events) == 0 {
+		return 0
+	}
+	return replay.Events[len(replay.Events)-1].Tick
+}
+
+// Setup is the simulation setup the match was recorded with. Replays recorded before
+// file contents were embedded read them from the recorded paths instead.
+func (replay *Replay) Setup() (*SimulationSetup, error) {
+	header := replay.Header
+	setup := &SimulationSetup{
+		MapPath:                header.MapPath,
+		MapData:                header.MapData,
+		ItemSpawnTablePath:     header.ItemSpawnTable,
+		ItemSpawnTableData:     header.ItemSpawnTableData,
+		WorldEventSchedulePath: header.WorldEventSchedule,
+		WorldEventScheduleData: header.WorldEventScheduleData,
+		GameMode:               header.GameMode,
+		TickRate:               replay.TickRate(),
+		Seed:                   header.Seed,
+	}
+	if err := setup.readFiles(); err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+// Run re-simulates the recorded match headlessly from its seed and inputs, calling
+// onStep with the whole world after every step. Returning false from onStep stops early.
+func (replay *Replay) Run(onStep func(*multiplayerv1.GameState) bool) error {
+	header := replay.Header
+	if replay.TickRate() <= 0 {
+		return fmt.Errorf("replay has no tick rate")
+	}
+
+	setup, err := replay.Setup()
+	if err != nil {
+		return err
+	}
+
+	room := &Room{
+		ID:      header.RoomId,
+		keys:    newRoomKeys(header.RoomId),
+		clients: make(map[*Client]bool),
+	}
+	// Steps are driven directly, so the clock never needs to move
+	clock := NewManualClock(time.Unix(0, header.StartedAtNs))
+	if err := room.buildSimulation(setup, clock, nil); err != nil {
+		return err
+	}
+	gsm := room.gameStateManager
+
+	events := replay.Events
+	for tick := int64(0); ; tick++ {
+		for len(events) > 0 && events[0].Tick <= tick {
+			event := events[0]
+			events = events[1:]
+			if event.Type == multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_END {
+				return nil
+			}
+			if err := room.applyReplayEvent(event); err != nil {
+				return fmt.Errorf("replay diverged at tick %d: %w", tick, err)
+			}
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		gsm.Step()
+		state, _ := gsm.buildGameState(gsm.simTime)
+		if !onStep(state) {
+			return nil
+		}
+	}
+}
+
+// applyReplayEvent feeds one recorded event back into the room
+func (room *Room) applyReplayEvent(event *multiplayerv1.ReplayEvent) error {
+	gsm := room.gameStateManager
+
+	switch event.Type {
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_JOIN:
+		if event.Bot {
+			return room.botManager.restoreBot(event.UserId, event.Username)
+		}
+		gsm.AddPlayer(event.UserId, event.Username)
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_LEAVE:
+		gsm.RemovePlayer(event.UserId)
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_IDLE:
+		gsm.IdlePlayer(event.UserId)
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_INPUT:
+		gsm.EnqueuePlayerEvent(event.UserId, &multiplayerv1.PlayerEvent{
+			Type:        multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT,
+			InputAction: event.InputAction,
+		})
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_ACTION:
+		gsm.EnqueuePlayerEvent(event.UserId, &multiplayerv1.PlayerEvent{
+			Type:       multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION,
+			GameAction: event.GameAction,
+		})
+	case multiplayerv1.ReplayEventType_REPLAY_EVENT_TYPE_SKIP:
+		gsm.skipAhead(time.Duration(event.SkippedNs))
+	default:
+		logger.Warn("[Replay] Skipping unknown event %v at tick %d", event.Type, event.Tick)
+	}
+	return nil
+}
+
+// restoreBot adds a recorded bot back into the room. The ID is drawn again rather than
+// taken from the recording so the room's RNG stays in step with the original match.
+func (bm *BotManager) restoreBot(id, name string) error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	if drawn := bm.nextBotID(); drawn != id {
+		return fmt.Errorf("bot %s was recorded as %s", drawn, id)
+	}
+	bm.addBot(id, name)
+	return nil
+}
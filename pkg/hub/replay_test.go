@@ -0,0 +1,51 @@
+package hub
+
+import (
+	"testing"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReplayMatchesRecordedRoom(t *testing.T) {
+	setup := newTestSetup(t, "freeze_tag", simTestSeed)
+	room, _ := newTestRoom(t, setup)
+	gsm := room.gameStateManager
+
+	room.startRecording(t.TempDir(), setup)
+	if gsm.recorder == nil {
+		t.Fatal("room is not recording")
+	}
+	path := gsm.recorder.file.Name()
+
+	addSimTestPlayers(room)
+	for step := range simTestTicks {
+		if step == simTestTicks/2 {
+			gsm.RemovePlayer(simTestHumans[1])
+		}
+		playSimTestScript(gsm, step)
+		gsm.Step()
+	}
+	live, _ := gsm.buildGameState(gsm.simTime)
+	gsm.stopRecording()
+
+	replay, err := LoadReplayFromFile(path)
+	if err != nil {
+		t.Fatalf("failed to load replay: %v", err)
+	}
+	var replayed *multiplayerv1.GameState
+	err = replay.Run(func(state *multiplayerv1.GameState) bool {
+		replayed = state
+		return true
+	})
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+
+	if replayed == nil || replayed.Tick != live.Tick {
+		t.Fatalf("replay ended at tick %d, want %d", replayed.GetTick(), live.Tick)
+	}
+	if !proto.Equal(sortedState(replayed), sortedState(live)) {
+		t.Error("replayed final state differs from the recorded room")
+	}
+}
@@ -0,0 +1,178 @@
+package hub
+
+import (
+	"fmt"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// heldSession keeps a disconnected player idle in their room until they
+// reconnect with the same session token or the grace period runs out.
+// It holds the room reference the old client acquired.
+type heldSession struct {
+	userID   string
+	username string
+	room     *Room
+	timer    *time.Timer
+}
+
+// holdSession parks an in-game player whose connection dropped. Returns false
+// if resuming is disabled or the client was not in the game.
+func (hub *Hub) holdSession(client *Client) bool {
+	grace := time.Duration(hub.cfg.SessionResumeGrace) * time.Second
+	if grace <= 0 || client.token == "" {
+		return false
+	}
+	if !client.room.gameStateManager.IdlePlayer(client.UserID) {
+		return false
+	}
+
+	held := &heldSession{
+		userID:   client.UserID,
+		username: client.Username,
+		room:     client.room,
+	}
+
+	hub.resumesMu.Lock()
+	if previous, ok := hub.resumes[client.token]; ok {
+		// Same token dropped twice without resuming, keep only one room reference
+		if previous.timer.Stop() {
+			hub.releaseRoom(previous.room)
+		}
+	}
+	hub.resumes[client.token] = held
+	held.timer = time.AfterFunc(grace, func() {
+		hub.expireSession(client.token, held)
+	})
+	hub.resumesMu.Unlock()
+
+	logger.Info("%s (%s) disconnected from room %s, holding for %v", client.Username, client.UserID, client.room.ID, grace)
+	return true
+}
+
+// resumeSession hands back the room a held session is waiting in, along with its room reference
+func (hub *Hub) resumeSession(token string) (*Room, bool) {
+	hub.resumesMu.Lock()
+	defer hub.resumesMu.Unlock()
+
+	held, ok := hub.resumes[token]
+	if !ok || !held.timer.Stop() {
+		// Not held, or the expiry is already running
+		return nil, false
+	}
+	delete(hub.resumes, token)
+
+	logger.Info("%s (%s) resumed in room %s", held.username, held.userID, held.room.ID)
+	return held.room, true
+}
+
+// claimSession reattaches a new connection to the user's player when the old one has
+// not been cleaned up by the time it registers. Its unregister may still be queued, or
+// it was held under a token the new connection did not resume with. Runs on the hub
+// goroutine, so it is ordered against removeClient.
+func (hub *Hub) claimSession(client *Client) {
+	if client.resumed {
+		return
+	}
+	if _, _, ok := client.room.gameStateManager.GetPlayerPosition(client.UserID); !ok {
+		return
+	}
+
+	hub.resumesMu.Lock()
+	for token, held := range hub.resumes {
+		if held.userID != client.UserID || held.room != client.room {
+			continue
+		}
+		// The client already holds its own room reference. Deleting the entry also
+		// stops an expiry that fired but has not run yet.
+		held.timer.Stop()
+		delete(hub.resumes, token)
+		hub.releaseRoom(held.room)
+	}
+	hub.resumesMu.Unlock()
+
+	client.resumed = true
+	logger.Info("%s (%s) took over their player in room %s", client.Username, client.UserID, client.room.ID)
+}
+
+// expireSession removes a held player whose grace period ran out and tells the room they left
+func (hub *Hub) expireSession(token string, held *heldSession) {
+	hub.resumesMu.Lock()
+	if hub.resumes[token] != held {
+		hub.resumesMu.Unlock()
+		return
+	}
+	delete(hub.resumes, token)
+	hub.resumesMu.Unlock()
+
+	room := held.room
+
+	// A fresh login under another token may have taken the player over
+	if !room.hasUser(held.userID, nil) {
+		logger.Info("%s (%s) did not reconnect, removing from room %s", held.username, held.userID, room.ID)
+		room.gameStateManager.RemovePlayer(held.userID)
+		room.clearUser(held.userID)
+		room.announceLeave(held.userID, held.username)
+		room.broadcastLobbyState()
+	}
+
+	hub.releaseRoom(room)
+}
+
+// sendResumeJoin tells a resumed client where its player is so it can rejoin the game view
+func (room *Room) sendResumeJoin(client *Client) {
+	x, y, ok := room.gameStateManager.GetPlayerPosition(client.UserID)
+	if !ok {
+		return
+	}
+
+	joinMsg := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+		Payload: &multiplayerv1.GameMessage_PlayerEvent{
+			PlayerEvent: &multiplayerv1.PlayerEvent{
+				Type:     multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_JOIN,
+				PlayerId: &multiplayerv1.ID{Value: client.UserID},
+				Position: &multiplayerv1.Vector2{X: x, Y: y},
+			},
+		},
+	}
+
+	wire, err := proto.Marshal(joinMsg)
+	if err != nil {
+		logger.Error("Failed to marshal resume join: %v", err)
+		return
+	}
+	client.queue(wire)
+}
+
+// announceLeave broadcasts that a player left the game
+func (room *Room) announceLeave(userID, username string) {
+	leaveMsg := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_PLAYER_EVENT,
+		Payload: &multiplayerv1.GameMessage_PlayerEvent{
+			PlayerEvent: &multiplayerv1.PlayerEvent{
+				Type:     multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_LEAVE,
+				PlayerId: &multiplayerv1.ID{Value: userID},
+			},
+		},
+	}
+	if wire, err := toWire(leaveMsg); err == nil {
+		room.broadcastToClients(wire)
+	}
+
+	announcement := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_ANNOUNCEMENT,
+		Payload: &multiplayerv1.GameMessage_ChatAnnouncement{
+			ChatAnnouncement: &multiplayerv1.Announcement{
+				Text:       fmt.Sprintf("%s left the game", username),
+				SentAtUnix: time.Now().UnixMilli(),
+			},
+		},
+	}
+	if wire, err := toWire(announcement); err == nil {
+		room.broadcastToClients(wire)
+	}
+}
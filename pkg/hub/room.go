@@ -0,0 +1,379 @@
+package hub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultRoomID = "main"
+	MaxRoomIDLen  = 32
+)
+
+// RoomKeys holds the Redis keys namespaced to a single room
+type RoomKeys struct {
+	LobbyUsers string
+	GameUsers  string
+	Usernames  string
+	Spectators string
+	BotGame    string
+	BotNames   string
+}
+
+func newRoomKeys(roomID string) RoomKeys {
+	return RoomKeys{
+		LobbyUsers: roomKey(roomID, RedisKeyLobbyUsers),
+		GameUsers:  roomKey(roomID, RedisKeyGameUsers),
+		Usernames:  roomKey(roomID, RedisKeyUsernames),
+		Spectators: roomKey(roomID, RedisKeySpectators),
+		BotGame:    roomKey(roomID, RedisKeyBotGame),
+		BotNames:   roomKey(roomID, RedisKeyBotNames),
+	}
+}
+
+// roomKey prefixes a Redis key with the room namespace, e.g. room:main:lobby:users
+func roomKey(roomID, key string) string {
+	return "room:" + roomID + ":" + key
+}
+
+// roomChannel returns the pub/sub channel for a room and space, e.g. room:main:chat.lobby
+func roomChannel(roomID string, space Space) string {
+	return roomKey(roomID, string(space))
+}
+
+// Room is a single match with its own game state, map, bots and members
+type Room struct {
+	ID   string
+	hub  *Hub
+	keys RoomKeys
+
+	clientsMu sync.RWMutex
+	clients   map[*Client]bool
+
+	// refs counts clients that have acquired the room, including ones not yet registered
+	refs int
+
+	gameMap          *GameMap
+	viewRadius       float32
+	gameStateManager *GameStateManager
+	botManager       *BotManager
+
+	spectatorDelay time.Duration
+	// spectatorViews holds recent views until they are old enough for spectators,
+	// only touched by the simulation loop
+	spectatorViews []*worldView
+}
+
+// newRoom loads a fresh map for the room and starts its simulation
+func newRoom(hub *Hub, id string) (*Room, error) {
+	setup, err := LoadSimulationSetup(hub.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	room := &Room{
+		ID:             id,
+		hub:            hub,
+		keys:           newRoomKeys(id),
+		clients:        make(map[*Client]bool),
+		viewRadius:     float32(hub.cfg.ViewRadius),
+		spectatorDelay: time.Duration(hub.cfg.SpectatorDelay) * time.Second,
+	}
+	if err := room.buildSimulation(setup, SystemClock, hub.redis); err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	room.clearRedisKeys(ctx)
+	room.startRecording(hub.cfg.ReplayDir, setup)
+
+	// Spawn bots once recording so their joins are in the replay
+	if err := room.botManager.Initialize(ctx); err != nil {
+		logger.Error("[Room %s] Failed to initialize bots: %v", id, err)
+	}
+
+	room.gameStateManager.Start()
+
+	logger.Info("[Room %s] Created with map %dx%d tiles and seed %d", id, room.gameMap.Width, room.gameMap.Height, setup.Seed)
+	return room, nil
+}
+
+// close stops the simulation and removes the room's Redis state
+func (room *Room) close() {
+	room.gameStateManager.Stop()
+	room.clearRedisKeys(context.Background())
+	logger.Info("[Room %s] Closed", room.ID)
+}
+
+func (room *Room) clearRedisKeys(ctx context.Context) {
+	keys := room.keys
+	if err := room.hub.redis.Del(ctx, keys.LobbyUsers, keys.GameUsers, keys.Usernames, keys.Spectators, keys.BotGame, keys.BotNames).Err(); err != nil {
+		logger.Error("[Room %s] Failed to clear Redis keys: %v", room.ID, err)
+	}
+}
+
+// channel returns the room's pub/sub channel for the given space
+func (room *Room) channel(space Space) string {
+	return roomChannel(room.ID, space)
+}
+
+func (room *Room) getTotalClients() int {
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	return len(room.clients)
+}
+
+func (room *Room) addClient(client *Client) {
+	room.clientsMu.Lock()
+	room.clients[client] = true
+	room.clientsMu.Unlock()
+
+	// Add user to lobby set in Redis, resumed players go straight back to the game
+	ctx := context.Background()
+	usersKey := room.keys.LobbyUsers
+	if client.resumed {
+		usersKey = room.keys.GameUsers
+	}
+	if err := room.hub.redis.SAdd(ctx, usersKey, client.UserID).Err(); err != nil {
+		logger.Error("Failed to add user to lobby in Redis: %v", err)
+	}
+
+	// Store the username mapping in Redis so we can look it up later
+	if err := room.hub.redis.HSet(ctx, room.keys.Usernames, client.UserID, client.Username).Err(); err != nil {
+		logger.Error("Failed to store username in Redis: %v", err)
+	}
+
+	logger.Info("%s (%s) joined room %s - room size: %d", client.Username, client.UserID, room.ID, room.getTotalClients())
+	if client.resumed {
+		room.sendResumeJoin(client)
+	}
+	room.broadcastLobbyState()
+}
+
+func (room *Room) removeClient(client *Client) {
+	room.detachClient(client)
+	room.clearUser(client.UserID)
+	room.broadcastLobbyState()
+}
+
+// detachClient stops sending to a client without touching the user's lobby or game membership
+func (room *Room) detachClient(client *Client) {
+	room.clientsMu.Lock()
+	defer room.clientsMu.Unlock()
+	if _, ok := room.clients[client]; ok {
+		delete(room.clients, client)
+		logger.Info("Remaining size of room %s: %d", room.ID, len(room.clients))
+	}
+}
+
+// hasUser reports whether a user has a connected player client in the room other than except
+func (room *Room) hasUser(userID string, except *Client) bool {
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	for client := range room.clients {
+		if client != except && !client.spectator && client.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// clearUser removes a user from the room's lobby, game and username entries in Redis
+func (room *Room) clearUser(userID string) {
+	// Remove user from both lobby and game sets in Redis
+	ctx := context.Background()
+	if err := room.hub.redis.SRem(ctx, room.keys.LobbyUsers, userID).Err(); err != nil {
+		logger.Error("Failed to remove user from lobby in Redis: %v", err)
+	}
+	// Remove username mapping
+	if err := room.hub.redis.HDel(ctx, room.keys.Usernames, userID).Err(); err != nil {
+		logger.Error("Failed to remove username from Redis: %v", err)
+	}
+	// Also remove from game users set
+	if err := room.hub.redis.SRem(ctx, room.keys.GameUsers, userID).Err(); err != nil {
+		logger.Error("Failed to remove user from game in Redis: %v", err)
+	}
+}
+
+// broadcastToClients queues a message for every client in this room without blocking
+func (room *Room) broadcastToClients(message []byte) {
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	for client := range room.clients {
+		client.queue(message)
+	}
+}
+
+// broadcastSnapshot sends every client the part of the world it can see,
+// delta encoded against whatever that client last acknowledged. Spectators are
+// sent an older view once one is past the spectator delay.
+func (room *Room) broadcastSnapshot(id int64, view *worldView) {
+	spectated := room.delayedView(view)
+
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	for client := range room.clients {
+		if !client.spectator {
+			client.queueSnapshot(newSnapshot(id, view.visibleTo(client.UserID, room.viewRadius)))
+		} else if spectated != nil {
+			client.queueSnapshot(newSnapshot(spectated.state.Tick, room.spectatorView(client, spectated)))
+		}
+	}
+}
+
+// resetSnapshots makes the next snapshot sent to a user a full one
+func (room *Room) resetSnapshots(userID string) {
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	for client := range room.clients {
+		if client.UserID == userID {
+			client.snapshots.reset()
+		}
+	}
+}
+
+// getUsername looks up a member's display name from the room's Redis hash
+func (room *Room) getUsername(userID string) string {
+	username, err := room.hub.redis.HGet(context.Background(), room.keys.Usernames, userID).Result()
+	if err != nil || username == "" {
+		return "Unknown"
+	}
+	return username
+}
+
+// MoveUserToGame moves a user from the lobby set to the game set in Redis
+func (room *Room) MoveUserToGame(userId string) error {
+	ctx := context.Background()
+	pipe := room.hub.redis.Pipeline()
+	pipe.SRem(ctx, room.keys.LobbyUsers, userId)
+	pipe.SAdd(ctx, room.keys.GameUsers, userId)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// MoveUserToLobby moves a user from the game set back to the lobby set in Redis
+func (room *Room) MoveUserToLobby(userId string) error {
+	ctx := context.Background()
+	pipe := room.hub.redis.Pipeline()
+	pipe.SRem(ctx, room.keys.GameUsers, userId)
+	pipe.SAdd(ctx, room.keys.LobbyUsers, userId)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// broadcastLobbyState sends the current lobby and game user state to all clients in the room
+func (room *Room) broadcastLobbyState() {
+	// Skip if no clients connected
+	clientCount := room.getTotalClients()
+	if clientCount == 0 {
+		return
+	}
+
+	logger.Info("[Room %s] broadcastLobbyState: broadcasting to %d clients", room.ID, clientCount)
+	ctx := context.Background()
+	redisClient := room.hub.redis
+
+	// Get lobby and game users from Redis
+	lobbyUserIds, err := redisClient.SMembers(ctx, room.keys.LobbyUsers).Result()
+	if err != nil {
+		logger.Error("Failed to get lobby users from Redis: %v", err)
+		return
+	}
+
+	gameUserIds, err := redisClient.SMembers(ctx, room.keys.GameUsers).Result()
+	if err != nil {
+		logger.Error("Failed to get game users from Redis: %v", err)
+		return
+	}
+
+	// Get username mappings from Redis
+	usernames, err := redisClient.HGetAll(ctx, room.keys.Usernames).Result()
+	if err != nil {
+		logger.Error("Failed to get usernames from Redis: %v", err)
+		usernames = make(map[string]string)
+	}
+
+	// Build lobby user list (humans only)
+	lobbyUsers := make([]*multiplayerv1.LobbyUser, 0, len(lobbyUserIds))
+	for _, id := range lobbyUserIds {
+		username := usernames[id]
+		if username == "" {
+			username = "Unknown"
+		}
+		lobbyUsers = append(lobbyUsers, &multiplayerv1.LobbyUser{
+			UserId:  &multiplayerv1.ID{Value: id},
+			Name:    username,
+			IsReady: false,
+		})
+	}
+
+	// Build game user list (humans + bots)
+	gameUsers := make([]*multiplayerv1.LobbyUser, 0, len(gameUserIds)+BotCount)
+	for _, id := range gameUserIds {
+		username := usernames[id]
+		if username == "" {
+			username = "Unknown"
+		}
+		gameUsers = append(gameUsers, &multiplayerv1.LobbyUser{
+			UserId:  &multiplayerv1.ID{Value: id},
+			Name:    username,
+			IsReady: true,
+			Team:    int32(room.gameStateManager.PlayerTeam(id)),
+		})
+	}
+
+	// Add bots to game user list
+	botIDs, err := redisClient.SMembers(ctx, room.keys.BotGame).Result()
+	if err != nil {
+		logger.Error("Failed to get bot IDs from Redis: %v", err)
+		botIDs = []string{}
+	}
+	botNames, err := redisClient.HGetAll(ctx, room.keys.BotNames).Result()
+	if err != nil {
+		logger.Error("Failed to get bot names from Redis: %v", err)
+		botNames = make(map[string]string)
+	}
+	for _, botID := range botIDs {
+		name := botNames[botID]
+		if name == "" {
+			name = "Bot"
+		}
+		gameUsers = append(gameUsers, &multiplayerv1.LobbyUser{
+			UserId:  &multiplayerv1.ID{Value: botID},
+			Name:    name,
+			IsReady: true,
+			Team:    int32(room.gameStateManager.PlayerTeam(botID)),
+		})
+	}
+
+	spectators := room.getSpectators(ctx)
+
+	lobbyState := &multiplayerv1.LobbyState{
+		LobbyUsers: lobbyUsers,
+		GameUsers:  gameUsers,
+		Spectators: spectators,
+	}
+
+	logger.Info("[Room %s] broadcastLobbyState: lobbyUsers=%d, gameUsers=%d, spectators=%d",
+		room.ID, len(lobbyUsers), len(gameUsers), len(spectators))
+
+	gameMsg := &multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_LOBBY_STATE,
+		Payload: &multiplayerv1.GameMessage_LobbyState{
+			LobbyState: lobbyState,
+		},
+	}
+
+	wire, err := proto.Marshal(gameMsg)
+	if err != nil {
+		logger.Error("broadcastLobbyState: failed to marshal: %v", err)
+		return
+	}
+
+	room.broadcastToClients(wire)
+}
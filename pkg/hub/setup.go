@@ -0,0 +1,122 @@
+package hub
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sonastea/WizardWarriors/pkg/config"
+)
+
+// RoomTickRate is how often a room's simulation steps (33 updates/sec)
+const RoomTickRate = 30 * time.Millisecond
+
+// SimulationSetup is everything a room's simulation is built from. File contents are
+// kept as they were read so a replay carries them instead of trusting the paths.
+type SimulationSetup struct {
+	MapPath                string
+	MapData                []byte
+	ItemSpawnTablePath     string
+	ItemSpawnTableData     []byte // Empty for the built-in table
+	WorldEventSchedulePath string
+	WorldEventScheduleData []byte // Empty for the built-in schedule
+	GameMode               string
+	TickRate               time.Duration
+	Seed                   int64
+}
+
+// LoadSimulationSetup reads the files a room is configured with. A zero seed in the
+// config picks a new one for every room.
+func LoadSimulationSetup(cfg *config.Config) (*SimulationSetup, error) {
+	setup := &SimulationSetup{
+		MapPath:                cfg.MapPath,
+		ItemSpawnTablePath:     cfg.ItemSpawnTable,
+		WorldEventSchedulePath: cfg.WorldEventSchedule,
+		GameMode:               cfg.GameMode,
+		TickRate:               RoomTickRate,
+		Seed:                   cfg.SimulationSeed,
+	}
+	if setup.Seed == 0 {
+		setup.Seed = time.Now().UnixNano()
+	}
+	if err := setup.readFiles(); err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+// readFiles loads the contents of any configured file not already in the setup
+func (setup *SimulationSetup) readFiles() error {
+	var err error
+	if len(setup.MapData) == 0 {
+		if setup.MapData, err = os.ReadFile(setup.MapPath); err != nil {
+			return fmt.Errorf("failed to read map file: %w", err)
+		}
+	}
+	if len(setup.ItemSpawnTableData) == 0 && setup.ItemSpawnTablePath != "" {
+		if setup.ItemSpawnTableData, err = os.ReadFile(setup.ItemSpawnTablePath); err != nil {
+			return fmt.Errorf("failed to read spawn table file: %w", err)
+		}
+	}
+	if len(setup.WorldEventScheduleData) == 0 && setup.WorldEventSchedulePath != "" {
+		if setup.WorldEventScheduleData, err = os.ReadFile(setup.WorldEventSchedulePath); err != nil {
+			return fmt.Errorf("failed to read event schedule file: %w", err)
+		}
+	}
+	return nil
+}
+
+// parsedSetup is a SimulationSetup with its files parsed
+type parsedSetup struct {
+	gameMap       *GameMap
+	spawnTable    *SpawnTable    // nil for the built-in table
+	eventSchedule *EventSchedule // nil for the built-in schedule
+	mode          GameMode
+}
+
+func (setup *SimulationSetup) parse() (*parsedSetup, error) {
+	parsed := &parsedSetup{}
+	var err error
+
+	if parsed.gameMap, err = ParseTiledMap(setup.MapData); err != nil {
+		return nil, fmt.Errorf("failed to load game map: %w", err)
+	}
+	if len(setup.ItemSpawnTableData) > 0 {
+		if parsed.spawnTable, err = ParseSpawnTable(setup.ItemSpawnTableData); err != nil {
+			return nil, fmt.Errorf("failed to load item spawn table: %w", err)
+		}
+	}
+	if len(setup.WorldEventScheduleData) > 0 {
+		if parsed.eventSchedule, err = ParseEventSchedule(setup.WorldEventScheduleData); err != nil {
+			return nil, fmt.Errorf("failed to load world event schedule: %w", err)
+		}
+	}
+	if parsed.mode, err = NewGameMode(setup.GameMode); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// buildSimulation creates the room's map, game state, game mode and bot manager from
+// setup. Live rooms and replays both build through here so the room's RNG is drawn in
+// the same order. redis is nil when replaying.
+func (room *Room) buildSimulation(setup *SimulationSetup, clock Clock, redis *redis.Client) error {
+	parsed, err := setup.parse()
+	if err != nil {
+		return err
+	}
+
+	room.gameMap = parsed.gameMap
+	gsm := NewGameStateManager(room, parsed.gameMap, setup.TickRate, clock, setup.Seed)
+	room.gameStateManager = gsm
+	if parsed.spawnTable != nil {
+		gsm.itemManager.SetSpawnTable(parsed.spawnTable)
+	}
+	if parsed.eventSchedule != nil {
+		gsm.SetEventSchedule(parsed.eventSchedule)
+	}
+	gsm.SetMode(parsed.mode)
+	room.botManager = NewBotManager(redis, gsm, parsed.gameMap, room.keys, gsm.rng)
+	return nil
+}
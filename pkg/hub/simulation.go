@@ -0,0 +1,84 @@
+package hub
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock is where the simulation gets the time from, so tests and replays can drive it
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock is the wall clock live rooms run on
+var SystemClock Clock = systemClock{}
+
+// ManualClock only moves when advanced
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+func (clock *ManualClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+// Advance moves the clock forward by d
+func (clock *ManualClock) Advance(d time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(d)
+}
+
+// lockedSource guards a rand.Source so one room's RNG can be shared by its managers
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+// NewRoomRand returns the random source for one room. Everything random in a room's
+// simulation draws from it, so the same seed and inputs play out the same way.
+func NewRoomRand(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{src: rand.NewSource(seed).(rand.Source64)})
+}
+
+// inIDOrder returns a map's values sorted by key. The simulation walks entities this
+// way wherever the order can change the outcome, since map order is random.
+func inIDOrder[V any](m map[string]V) []V {
+	values := make([]V, 0, len(m))
+	for _, id := range slices.Sorted(maps.Keys(m)) {
+		values = append(values, m[id])
+	}
+	return values
+}
@@ -0,0 +1,111 @@
+package hub
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	simTestSeed  = 42
+	simTestBots  = 4
+	simTestTicks = 2000
+)
+
+var simTestHumans = []string{"1", "2"}
+
+// newSimTestRoom builds a freeze tag room with humans and bots on a manual clock
+func newSimTestRoom(t *testing.T, seed int64) (*GameStateManager, *ManualClock) {
+	t.Helper()
+
+	room, clock := newTestRoom(t, newTestSetup(t, "freeze_tag", seed))
+	addSimTestPlayers(room)
+	return room.gameStateManager, clock
+}
+
+// addSimTestPlayers puts the scripted humans and the bots into a room
+func addSimTestPlayers(room *Room) {
+	for _, id := range simTestHumans {
+		room.gameStateManager.AddPlayer(id, "player-"+id)
+	}
+	addTestBots(room, simTestBots)
+}
+
+// playSimTestScript feeds the humans a fixed pattern of movement and attacks
+func playSimTestScript(gsm *GameStateManager, step int) {
+	inputs := []multiplayerv1.InputType{
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_RIGHT,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_DOWN,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_LEFT,
+		multiplayerv1.InputType_INPUT_TYPE_MOVE_UP,
+	}
+
+	for i, id := range simTestHumans {
+		if step%40 == i*10 {
+			input := inputs[(step/40+i)%len(inputs)]
+			gsm.EnqueuePlayerEvent(id, &multiplayerv1.PlayerEvent{
+				Type:        multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_INPUT,
+				InputAction: &multiplayerv1.InputAction{Input: input, Pressed: step%80 < 40},
+			})
+		}
+		if step%25 == i*5 {
+			action := multiplayerv1.ActionType_ACTION_TYPE_THROW_POTION
+			if step%50 == i*5 {
+				action = multiplayerv1.ActionType_ACTION_TYPE_CAST_FIREBALL
+			}
+			gsm.EnqueuePlayerEvent(id, &multiplayerv1.PlayerEvent{
+				Type: multiplayerv1.PlayerEventType_PLAYER_EVENT_TYPE_ACTION,
+				GameAction: &multiplayerv1.GameAction{
+					Action: action,
+					Target: &multiplayerv1.Vector2{X: float32(step%40) * 30, Y: float32(step%30) * 30},
+				},
+			})
+		}
+	}
+}
+
+// sortedState sorts a GameState's entities by ID, since they are listed in map order
+func sortedState(state *multiplayerv1.GameState) *multiplayerv1.GameState {
+	slices.SortFunc(state.Players, func(a, b *multiplayerv1.PlayerState) int {
+		return strings.Compare(a.PlayerId.GetValue(), b.PlayerId.GetValue())
+	})
+	slices.SortFunc(state.Projectiles, func(a, b *multiplayerv1.ProjectileState) int {
+		return strings.Compare(a.ProjectileId, b.ProjectileId)
+	})
+	slices.SortFunc(state.Items, func(a, b *multiplayerv1.ItemState) int {
+		return strings.Compare(a.ItemId, b.ItemId)
+	})
+	return state
+}
+
+func TestSameSeedAndInputsPlayOutTheSame(t *testing.T) {
+	first, firstClock := newSimTestRoom(t, simTestSeed)
+	second, secondClock := newSimTestRoom(t, simTestSeed)
+
+	// Uneven clock steps exercise the accumulator as well as the steps themselves
+	advances := []time.Duration{17 * time.Millisecond, 30 * time.Millisecond, 45 * time.Millisecond}
+	for step := range simTestTicks {
+		playSimTestScript(first, step)
+		playSimTestScript(second, step)
+
+		advance := advances[step%len(advances)]
+		firstClock.Advance(advance)
+		secondClock.Advance(advance)
+		first.tick()
+		second.tick()
+
+		firstState, _ := first.buildGameState(first.simTime)
+		secondState, _ := second.buildGameState(second.simTime)
+		if !proto.Equal(sortedState(firstState), sortedState(secondState)) {
+			t.Fatalf("rooms diverged at tick %d", firstState.Tick)
+		}
+	}
+
+	if first.tickNumber == 0 {
+		t.Fatal("simulation never stepped")
+	}
+}
@@ -0,0 +1,181 @@
+package hub
+
+import (
+	"sync"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// Snapshots kept per client for acks to refer back to (~2s at 30ms ticks)
+const snapshotHistorySize = 64
+
+// snapshot is the GameState one client was sent on a tick, indexed by entity ID
+// for delta encoding. Entity messages are shared and must not be modified.
+type snapshot struct {
+	id          int64
+	state       *multiplayerv1.GameState
+	players     map[string]*multiplayerv1.PlayerState
+	projectiles map[string]*multiplayerv1.ProjectileState
+	items       map[string]*multiplayerv1.ItemState
+	worldEvents map[string]*multiplayerv1.WorldEvent
+}
+
+func newSnapshot(id int64, state *multiplayerv1.GameState) *snapshot {
+	state.SnapshotId = id
+
+	snap := &snapshot{
+		id:          id,
+		state:       state,
+		players:     make(map[string]*multiplayerv1.PlayerState, len(state.Players)),
+		projectiles: make(map[string]*multiplayerv1.ProjectileState, len(state.Projectiles)),
+		items:       make(map[string]*multiplayerv1.ItemState, len(state.Items)),
+		worldEvents: make(map[string]*multiplayerv1.WorldEvent, len(state.WorldEvents)),
+	}
+	for _, player := range state.Players {
+		snap.players[player.PlayerId.GetValue()] = player
+	}
+	for _, projectile := range state.Projectiles {
+		snap.projectiles[projectile.ProjectileId] = projectile
+	}
+	for _, item := range state.Items {
+		snap.items[item.ItemId] = item
+	}
+	for _, event := range state.WorldEvents {
+		snap.worldEvents[event.EventId] = event
+	}
+	return snap
+}
+
+// delta returns a GameState with only what changed since baseline
+func (snap *snapshot) delta(baseline *snapshot) *multiplayerv1.GameState {
+	state := &multiplayerv1.GameState{
+		SnapshotId:   snap.id,
+		BaselineId:   baseline.id,
+		Tick:         snap.state.Tick,
+		ServerTimeMs: snap.state.ServerTimeMs,
+		Match:        snap.state.Match,
+	}
+
+	for id, player := range snap.players {
+		if old, ok := baseline.players[id]; !ok || !proto.Equal(old, player) {
+			state.Players = append(state.Players, player)
+		}
+	}
+	for id := range baseline.players {
+		if _, ok := snap.players[id]; !ok {
+			state.RemovedPlayers = append(state.RemovedPlayers, &multiplayerv1.ID{Value: id})
+		}
+	}
+
+	for id, projectile := range snap.projectiles {
+		if old, ok := baseline.projectiles[id]; !ok || !proto.Equal(old, projectile) {
+			state.Projectiles = append(state.Projectiles, projectile)
+		}
+	}
+	for id := range baseline.projectiles {
+		if _, ok := snap.projectiles[id]; !ok {
+			state.RemovedProjectiles = append(state.RemovedProjectiles, id)
+		}
+	}
+
+	for id, item := range snap.items {
+		if old, ok := baseline.items[id]; !ok || !proto.Equal(old, item) {
+			state.Items = append(state.Items, item)
+		}
+	}
+	for id := range baseline.items {
+		if _, ok := snap.items[id]; !ok {
+			state.RemovedItems = append(state.RemovedItems, id)
+		}
+	}
+
+	for _, event := range snap.state.WorldEvents {
+		if old, ok := baseline.worldEvents[event.EventId]; !ok || !proto.Equal(old, event) {
+			state.WorldEvents = append(state.WorldEvents, event)
+		}
+	}
+	for id := range baseline.worldEvents {
+		if _, ok := snap.worldEvents[id]; !ok {
+			state.RemovedWorldEvents = append(state.RemovedWorldEvents, id)
+		}
+	}
+
+	switch quicksand := snap.state.QuicksandEvent; {
+	case quicksand == nil:
+		state.QuicksandCleared = baseline.state.QuicksandEvent != nil
+	case !proto.Equal(quicksand, baseline.state.QuicksandEvent):
+		state.QuicksandEvent = quicksand
+	}
+
+	return state
+}
+
+// encode returns the wire message for this snapshot against baseline,
+// or the full snapshot if baseline is nil
+func (snap *snapshot) encode(baseline *snapshot) ([]byte, error) {
+	state := snap.state
+	if baseline != nil {
+		state = snap.delta(baseline)
+	}
+
+	return proto.Marshal(&multiplayerv1.GameMessage{
+		Type: multiplayerv1.GameMessageType_GAME_MESSAGE_TYPE_GAME_STATE,
+		Payload: &multiplayerv1.GameMessage_GameState{
+			GameState: state,
+		},
+	})
+}
+
+// snapshotHistory tracks the snapshots sent to one client and the last one it acked
+type snapshotHistory struct {
+	mu      sync.Mutex
+	sent    [snapshotHistorySize]*snapshot
+	ackedID int64
+}
+
+func (h *snapshotHistory) record(snap *snapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sent[snap.id%snapshotHistorySize] = snap
+}
+
+// ack marks a snapshot as received; stale or unknown acks are ignored
+func (h *snapshotHistory) ack(id int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if id <= h.ackedID {
+		return
+	}
+	if snap := h.sent[id%snapshotHistorySize]; snap == nil || snap.id != id {
+		logger.Debug("Ignoring ack for unknown snapshot %d", id)
+		return
+	}
+	h.ackedID = id
+}
+
+// baseline returns the acked snapshot, or nil if the client needs a full snapshot
+func (h *snapshotHistory) baseline() *snapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.ackedID == 0 {
+		return nil
+	}
+	if snap := h.sent[h.ackedID%snapshotHistorySize]; snap != nil && snap.id == h.ackedID {
+		return snap
+	}
+
+	// Acked snapshot fell out of the history, start over from a full one
+	h.ackedID = 0
+	return nil
+}
+
+// reset forgets the baseline so the next snapshot is sent in full
+func (h *snapshotHistory) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.ackedID = 0
+}
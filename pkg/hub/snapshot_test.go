@@ -0,0 +1,70 @@
+package hub
+
+import (
+	"testing"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	snapshotTestUserID     = "1"
+	snapshotTestViewRadius = 800
+)
+
+func newSnapshotTestGameState(t *testing.T) *GameStateManager {
+	t.Helper()
+
+	room, _ := newTestRoom(t, newTestSetup(t, "ffa", 1))
+	room.gameStateManager.AddPlayer(snapshotTestUserID, "snapshot")
+	return room.gameStateManager
+}
+
+// takeSnapshot steps the simulation once and returns what the test player is sent
+func takeSnapshot(t *testing.T, gsm *GameStateManager) *snapshot {
+	t.Helper()
+
+	gsm.Step()
+	gameState, visionScale := gsm.buildGameState(gsm.simTime)
+	view := newWorldView(gsm.gameMap, gameState)
+	view.visionScale = visionScale
+	return newSnapshot(gameState.Tick, view.visibleTo(snapshotTestUserID, snapshotTestViewRadius))
+}
+
+func decodeSnapshot(t *testing.T, snap, baseline *snapshot) *multiplayerv1.GameState {
+	t.Helper()
+
+	wire, err := snap.encode(baseline)
+	if err != nil {
+		t.Fatalf("failed to encode snapshot: %v", err)
+	}
+	msg := &multiplayerv1.GameMessage{}
+	if err := proto.Unmarshal(wire, msg); err != nil {
+		t.Fatalf("failed to decode snapshot: %v", err)
+	}
+	return msg.GetGameState()
+}
+
+func TestSnapshotCarriesTickAndServerTime(t *testing.T) {
+	gsm := newSnapshotTestGameState(t)
+
+	baseline := takeSnapshot(t, gsm)
+	full := decodeSnapshot(t, baseline, nil)
+	if full.Tick != gsm.tickNumber || full.Tick == 0 {
+		t.Errorf("full snapshot tick = %d, want %d", full.Tick, gsm.tickNumber)
+	}
+	if full.ServerTimeMs != gsm.simTime.UnixMilli() {
+		t.Errorf("full snapshot server time = %d, want %d", full.ServerTimeMs, gsm.simTime.UnixMilli())
+	}
+
+	delta := decodeSnapshot(t, takeSnapshot(t, gsm), baseline)
+	if delta.BaselineId != baseline.id {
+		t.Errorf("delta baseline = %d, want %d", delta.BaselineId, baseline.id)
+	}
+	if delta.Tick != gsm.tickNumber || delta.Tick == full.Tick {
+		t.Errorf("delta tick = %d, want %d", delta.Tick, gsm.tickNumber)
+	}
+	if delta.ServerTimeMs != gsm.simTime.UnixMilli() {
+		t.Errorf("delta server time = %d, want %d", delta.ServerTimeMs, gsm.simTime.UnixMilli())
+	}
+}
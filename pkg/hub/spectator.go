@@ -0,0 +1,132 @@
+package hub
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+// follow sets the player a spectator watches, empty for an overview of the map
+func (client *Client) follow(playerID string) {
+	client.Lock()
+	client.following = playerID
+	client.Unlock()
+	logger.Debug("Spectator %s (%s) now following %q", client.Username, client.UserID, playerID)
+}
+
+// followTarget returns the player a spectator watches, empty for an overview of the map
+func (client *Client) followTarget() string {
+	client.RLock()
+	defer client.RUnlock()
+	return client.following
+}
+
+// spectatorView picks the part of a delayed view a spectator is sent: the surroundings
+// of the player they follow or the whole map
+func (room *Room) spectatorView(client *Client, view *worldView) *multiplayerv1.GameState {
+	// Following someone no longer in the game falls back to the overview
+	if target := client.followTarget(); target != "" {
+		if _, ok := view.players[target]; ok {
+			return view.visibleTo(target, room.viewRadius)
+		}
+	}
+	return view.overview()
+}
+
+// delayedView queues the latest view and returns the newest one that is at least the
+// spectator delay old, or nil if none has become old enough since the last call.
+// Spectators watching behind live play cannot call out positions to players.
+func (room *Room) delayedView(view *worldView) *worldView {
+	if room.spectatorDelay <= 0 {
+		return view
+	}
+
+	room.spectatorViews = append(room.spectatorViews, view)
+	cutoff := view.state.ServerTimeMs - room.spectatorDelay.Milliseconds()
+	ready := 0
+	for ready < len(room.spectatorViews) && room.spectatorViews[ready].state.ServerTimeMs <= cutoff {
+		ready++
+	}
+	if ready == 0 {
+		return nil
+	}
+
+	delayed := room.spectatorViews[ready-1]
+	room.spectatorViews = slices.Delete(room.spectatorViews, 0, ready)
+	return delayed
+}
+
+// hasPlayer reports whether a user plays in the room, either connected or held for a resume
+func (room *Room) hasPlayer(userID string) bool {
+	if _, _, ok := room.gameStateManager.GetPlayerPosition(userID); ok {
+		return true
+	}
+	return room.hasUser(userID, nil)
+}
+
+// addSpectator registers a spectator client. Spectators get game state and chat but
+// stay out of the lobby and game user sets.
+func (room *Room) addSpectator(client *Client) {
+	room.clientsMu.Lock()
+	room.clients[client] = true
+	room.clientsMu.Unlock()
+
+	ctx := context.Background()
+	if err := room.hub.redis.HSet(ctx, room.keys.Spectators, client.UserID, client.Username).Err(); err != nil {
+		logger.Error("Failed to add spectator in Redis: %v", err)
+	}
+
+	logger.Info("%s (%s) is spectating room %s - room size: %d", client.Username, client.UserID, room.ID, room.getTotalClients())
+	room.broadcastLobbyState()
+}
+
+// removeSpectator drops a spectator client, keeping the user listed while they
+// still spectate from another connection
+func (room *Room) removeSpectator(client *Client) {
+	room.detachClient(client)
+	if !room.hasSpectator(client.UserID) {
+		if err := room.hub.redis.HDel(context.Background(), room.keys.Spectators, client.UserID).Err(); err != nil {
+			logger.Error("Failed to remove spectator from Redis: %v", err)
+		}
+	}
+	room.broadcastLobbyState()
+}
+
+// hasSpectator reports whether a user has a connected spectator client in the room
+func (room *Room) hasSpectator(userID string) bool {
+	room.clientsMu.RLock()
+	defer room.clientsMu.RUnlock()
+	for client := range room.clients {
+		if client.spectator && client.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// getSpectators lists the room's spectators sorted by name for the lobby state
+func (room *Room) getSpectators(ctx context.Context) []*multiplayerv1.LobbyUser {
+	names, err := room.hub.redis.HGetAll(ctx, room.keys.Spectators).Result()
+	if err != nil {
+		logger.Error("Failed to get spectators from Redis: %v", err)
+		return nil
+	}
+
+	spectators := make([]*multiplayerv1.LobbyUser, 0, len(names))
+	for id, name := range names {
+		if name == "" {
+			name = "Unknown"
+		}
+		spectators = append(spectators, &multiplayerv1.LobbyUser{
+			UserId: &multiplayerv1.ID{Value: id},
+			Name:   name,
+		})
+	}
+	slices.SortFunc(spectators, func(a, b *multiplayerv1.LobbyUser) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return spectators
+}
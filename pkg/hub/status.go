@@ -0,0 +1,321 @@
+package hub
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+type StatusEffectType int
+
+const (
+	StatusFrozen StatusEffectType = iota + 1
+	StatusFreezeImmunity
+	StatusSpeedBoost
+	StatusSpawnProtection
+	StatusShield
+	StatusInvisible
+	StatusCarryingFlag
+	StatusOutsideZone
+)
+
+// StackRule decides what happens when an effect is applied while already active
+type StackRule int
+
+const (
+	StackRefresh StackRule = iota // Restart the duration
+	StackExtend                   // Add the duration to what is left
+	StackIgnore                   // Keep the current effect untouched
+	StackAdd                      // Add a stack (up to MaxStacks) and restart the duration
+)
+
+// StatusEffectDef describes how a status effect behaves
+type StatusEffectDef struct {
+	Name            string
+	Duration        time.Duration
+	Stacking        StackRule
+	MaxStacks       int
+	SpeedMultiplier float32 // Applied once per stack, 0 roots the player
+	BlocksActions   bool
+	BlocksDamage    bool
+	Blocks          []StatusEffectType // Effects that cannot be applied while this one is active
+
+	// OnApply runs when the effect is first applied, OnExpire when it runs out.
+	// Both run with gsm.mu held.
+	OnApply  func(gsm *GameStateManager, player *PlayerState, now time.Time)
+	OnExpire func(gsm *GameStateManager, player *PlayerState, now time.Time)
+}
+
+// StatusEffect is an active effect on a player
+type StatusEffect struct {
+	Type      StatusEffectType
+	Stacks    int
+	ExpiresAt time.Time
+	SourceID  string
+}
+
+var statusEffects = make(map[StatusEffectType]*StatusEffectDef)
+
+// RegisterStatusEffect defines the behaviour of a status effect type
+func RegisterStatusEffect(effectType StatusEffectType, def *StatusEffectDef) {
+	if _, exists := statusEffects[effectType]; exists {
+		panic(fmt.Sprintf("status effect already registered for %d", effectType))
+	}
+	statusEffects[effectType] = def
+}
+
+func init() {
+	RegisterStatusEffect(StatusFrozen, &StatusEffectDef{
+		Name:            "Frozen",
+		Duration:        time.Duration(FreezeDuration * float64(time.Second)),
+		Stacking:        StackIgnore,
+		SpeedMultiplier: 0,
+		BlocksActions:   true,
+		OnApply: func(gsm *GameStateManager, player *PlayerState, now time.Time) {
+			player.RemoveStatus(StatusSpeedBoost)
+		},
+		// Thawing grants a short immunity and a burst of speed to escape
+		OnExpire: func(gsm *GameStateManager, player *PlayerState, now time.Time) {
+			gsm.applyStatus(player, StatusFreezeImmunity, now, "")
+			gsm.applyStatus(player, StatusSpeedBoost, now, "")
+		},
+	})
+	RegisterStatusEffect(StatusFreezeImmunity, &StatusEffectDef{
+		Name:            "Freeze Immunity",
+		Duration:        time.Duration(FreezeImmunityTime * float64(time.Second)),
+		Stacking:        StackRefresh,
+		SpeedMultiplier: 1,
+		Blocks:          []StatusEffectType{StatusFrozen},
+	})
+	RegisterStatusEffect(StatusSpeedBoost, &StatusEffectDef{
+		Name:            "Speed Boost",
+		Duration:        time.Duration(SpeedBoostDuration * float64(time.Second)),
+		Stacking:        StackRefresh,
+		SpeedMultiplier: SpeedBoostMultiplier,
+	})
+	RegisterStatusEffect(StatusSpawnProtection, &StatusEffectDef{
+		Name:            "Spawn Protection",
+		Duration:        SpawnInvulnerability,
+		Stacking:        StackRefresh,
+		SpeedMultiplier: 1,
+		BlocksDamage:    true,
+		Blocks:          []StatusEffectType{StatusFrozen},
+	})
+	RegisterStatusEffect(StatusShield, &StatusEffectDef{
+		Name:            "Shield",
+		Duration:        AloeShieldDuration,
+		Stacking:        StackExtend,
+		SpeedMultiplier: 1,
+		BlocksDamage:    true,
+		Blocks:          []StatusEffectType{StatusFrozen},
+	})
+	RegisterStatusEffect(StatusInvisible, &StatusEffectDef{
+		Name:            "Invisible",
+		Duration:        InvisibilityDuration,
+		Stacking:        StackRefresh,
+		SpeedMultiplier: 1,
+	})
+	// Lasts until the flag is dropped or captured, the duration only bounds it to a round
+	RegisterStatusEffect(StatusCarryingFlag, &StatusEffectDef{
+		Name:            "Carrying Flag",
+		Duration:        MatchRoundDuration,
+		Stacking:        StackIgnore,
+		SpeedMultiplier: CTFCarrierSpeed,
+	})
+	// Kept up while outside the battle royale zone, so it fades soon after getting back in
+	RegisterStatusEffect(StatusOutsideZone, &StatusEffectDef{
+		Name:            "Outside Zone",
+		Duration:        BRZoneTick + time.Second,
+		Stacking:        StackAdd,
+		MaxStacks:       BRMaxZoneStacks,
+		SpeedMultiplier: BRZoneSlowdown,
+	})
+}
+
+// HasStatus reports whether the player currently has the effect
+func (p *PlayerState) HasStatus(effectType StatusEffectType) bool {
+	return p.status(effectType) != nil
+}
+
+func (p *PlayerState) status(effectType StatusEffectType) *StatusEffect {
+	for _, effect := range p.Effects {
+		if effect.Type == effectType {
+			return effect
+		}
+	}
+	return nil
+}
+
+// RemoveStatus drops an effect without running its expiry hook
+func (p *PlayerState) RemoveStatus(effectType StatusEffectType) {
+	p.Effects = slices.DeleteFunc(p.Effects, func(effect *StatusEffect) bool {
+		return effect.Type == effectType
+	})
+}
+
+// SpeedMultiplier combines the movement modifiers of every active effect
+func (p *PlayerState) SpeedMultiplier() float32 {
+	multiplier := float32(1)
+	for _, effect := range p.Effects {
+		for range effect.Stacks {
+			multiplier *= statusEffects[effect.Type].SpeedMultiplier
+		}
+	}
+	return multiplier
+}
+
+// ActionsBlocked reports whether any active effect prevents using abilities
+func (p *PlayerState) ActionsBlocked() bool {
+	return slices.ContainsFunc(p.Effects, func(effect *StatusEffect) bool {
+		return statusEffects[effect.Type].BlocksActions
+	})
+}
+
+// DamageBlocked reports whether any active effect prevents taking damage
+func (p *PlayerState) DamageBlocked() bool {
+	return slices.ContainsFunc(p.Effects, func(effect *StatusEffect) bool {
+		return statusEffects[effect.Type].BlocksDamage
+	})
+}
+
+// statusBlocked reports whether an active effect prevents applying effectType
+func (p *PlayerState) statusBlocked(effectType StatusEffectType) bool {
+	return slices.ContainsFunc(p.Effects, func(effect *StatusEffect) bool {
+		return slices.Contains(statusEffects[effect.Type].Blocks, effectType)
+	})
+}
+
+// applyStatus applies an effect for its default duration following its stacking rule.
+// Returns false if another effect blocked it or it was already active and ignores
+// reapplication. Caller must hold gsm.mu.
+func (gsm *GameStateManager) applyStatus(player *PlayerState, effectType StatusEffectType, now time.Time, sourceID string) bool {
+	def, ok := statusEffects[effectType]
+	if !ok {
+		return false
+	}
+	return gsm.applyStatusFor(player, effectType, now, sourceID, def.Duration)
+}
+
+// applyStatusFor is applyStatus with a duration other than the effect's default.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) applyStatusFor(player *PlayerState, effectType StatusEffectType, now time.Time, sourceID string, duration time.Duration) bool {
+	def, ok := statusEffects[effectType]
+	if !ok || player.statusBlocked(effectType) {
+		return false
+	}
+
+	if existing := player.status(effectType); existing != nil {
+		switch def.Stacking {
+		case StackIgnore:
+			return false
+		case StackRefresh:
+			existing.ExpiresAt = now.Add(duration)
+		case StackExtend:
+			existing.ExpiresAt = existing.ExpiresAt.Add(duration)
+		case StackAdd:
+			existing.Stacks = min(existing.Stacks+1, max(def.MaxStacks, 1))
+			existing.ExpiresAt = now.Add(duration)
+		}
+		existing.SourceID = sourceID
+		return true
+	}
+
+	player.Effects = append(player.Effects, &StatusEffect{
+		Type:      effectType,
+		Stacks:    1,
+		ExpiresAt: now.Add(duration),
+		SourceID:  sourceID,
+	})
+	if def.OnApply != nil {
+		def.OnApply(gsm, player, now)
+	}
+	logger.Debug("Player %s gained %s", player.UserID, def.Name)
+	return true
+}
+
+// expireStatus ends an effect early, running its expiry hook as if it ran out.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) expireStatus(player *PlayerState, effectType StatusEffectType, now time.Time) {
+	if !player.HasStatus(effectType) {
+		return
+	}
+	player.RemoveStatus(effectType)
+	if onExpire := statusEffects[effectType].OnExpire; onExpire != nil {
+		onExpire(gsm, player, now)
+	}
+}
+
+// updateStatusEffects removes expired effects and runs their expiry hooks.
+// Caller must hold gsm.mu.
+func (gsm *GameStateManager) updateStatusEffects(now time.Time) {
+	for _, player := range inIDOrder(gsm.players) {
+		var expired []StatusEffectType
+		player.Effects = slices.DeleteFunc(player.Effects, func(effect *StatusEffect) bool {
+			if now.Before(effect.ExpiresAt) {
+				return false
+			}
+			expired = append(expired, effect.Type)
+			return true
+		})
+
+		// Hooks run after removal so they can reapply or chain into other effects
+		for _, effectType := range expired {
+			if onExpire := statusEffects[effectType].OnExpire; onExpire != nil {
+				onExpire(gsm, player, now)
+			}
+		}
+	}
+}
+
+// statusExpiry returns when an effect ends, or the zero time if it is not active
+func (p *PlayerState) statusExpiry(effectType StatusEffectType) time.Time {
+	if effect := p.status(effectType); effect != nil {
+		return effect.ExpiresAt
+	}
+	return time.Time{}
+}
+
+// buildStatusEffects converts a player's active effects to protobuf, ordered by type
+func buildStatusEffects(player *PlayerState) []*multiplayerv1.StatusEffect {
+	effects := make([]*multiplayerv1.StatusEffect, 0, len(player.Effects))
+	for _, effect := range player.Effects {
+		def := statusEffects[effect.Type]
+		effects = append(effects, &multiplayerv1.StatusEffect{
+			Type:            protoStatusEffectType(effect.Type),
+			ExpiresAtMs:     effect.ExpiresAt.UnixMilli(),
+			Stacks:          int32(effect.Stacks),
+			SpeedMultiplier: def.SpeedMultiplier,
+			BlocksActions:   def.BlocksActions,
+		})
+	}
+
+	slices.SortFunc(effects, func(a, b *multiplayerv1.StatusEffect) int {
+		return int(a.Type) - int(b.Type)
+	})
+	return effects
+}
+
+func protoStatusEffectType(effectType StatusEffectType) multiplayerv1.StatusEffectType {
+	switch effectType {
+	case StatusFrozen:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_FROZEN
+	case StatusFreezeImmunity:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_FREEZE_IMMUNITY
+	case StatusSpeedBoost:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_SPEED_BOOST
+	case StatusSpawnProtection:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_SPAWN_PROTECTION
+	case StatusShield:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_SHIELD
+	case StatusInvisible:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_INVISIBLE
+	case StatusCarryingFlag:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_CARRYING_FLAG
+	case StatusOutsideZone:
+		return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_OUTSIDE_ZONE
+	}
+	return multiplayerv1.StatusEffectType_STATUS_EFFECT_TYPE_UNSPECIFIED
+}
@@ -0,0 +1,281 @@
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	multiplayerv1 "github.com/sonastea/WizardWarriors/common/gen/multiplayer/v1"
+	"github.com/sonastea/WizardWarriors/pkg/logger"
+)
+
+// WorldEvent is a temporary map-wide event started by the event scheduler. Every
+// method runs with gsm.mu held.
+type WorldEvent interface {
+	Kind() multiplayerv1.WorldEventKind
+
+	// Start sets the event up, returning false if it could not run this time
+	Start(gsm *GameStateManager, now time.Time) bool
+
+	// Update runs every tick while the event is active
+	Update(gsm *GameStateManager, now time.Time)
+
+	// End undoes anything the event left on the map
+	End(gsm *GameStateManager, now time.Time)
+
+	// Describe fills the kind specific fields of the broadcast event
+	Describe(gsm *GameStateManager, state *multiplayerv1.WorldEvent)
+}
+
+// slowingEvent is implemented by events that slow movement at some positions
+type slowingEvent interface {
+	SlowsAt(gameMap *GameMap, x, y float32) bool
+}
+
+// visionEvent is implemented by events that shrink everyone's view radius
+type visionEvent interface {
+	VisionScale() float32
+}
+
+var worldEventKinds = map[string]func() WorldEvent{
+	"quicksand":     func() WorldEvent { return &Quicksand{} },
+	"sandstorm":     func() WorldEvent { return &Sandstorm{} },
+	"meteor_shower": func() WorldEvent { return &MeteorShower{} },
+	"aloe_bloom":    func() WorldEvent { return &AloeBloom{} },
+}
+
+// EventScheduleEntry runs one kind of world event on a fixed interval
+type EventScheduleEntry struct {
+	Kind            string  `json:"kind"`
+	IntervalSeconds float64 `json:"interval_seconds"` // Time from one start to the next
+	DurationSeconds float64 `json:"duration_seconds"` // How long each run lasts
+	DelaySeconds    float64 `json:"delay_seconds"`    // Time before the first run, 0 waits one interval
+}
+
+// EventSchedule lists the world events a room runs
+type EventSchedule struct {
+	Events []EventScheduleEntry `json:"events"`
+}
+
+// DefaultEventSchedule is used when no event schedule file is configured
+func DefaultEventSchedule() *EventSchedule {
+	schedule := &EventSchedule{
+		Events: []EventScheduleEntry{
+			{
+				Kind:            "quicksand",
+				IntervalSeconds: QuicksandEventInterval.Seconds(),
+				DurationSeconds: QuicksandEventDuration.Seconds(),
+			},
+		},
+	}
+	if err := schedule.validate(); err != nil {
+		panic(err)
+	}
+	return schedule
+}
+
+// LoadEventScheduleFromFile loads a world event schedule from a JSON file
+func LoadEventScheduleFromFile(path string) (*EventSchedule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event schedule file: %w", err)
+	}
+
+	return ParseEventSchedule(data)
+}
+
+// ParseEventSchedule parses world event schedule JSON data
+func ParseEventSchedule(data []byte) (*EventSchedule, error) {
+	var schedule EventSchedule
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to parse event schedule JSON: %w", err)
+	}
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// validate rejects unknown kinds and schedules that would run an event forever
+func (schedule *EventSchedule) validate() error {
+	for _, entry := range schedule.Events {
+		if _, ok := worldEventKinds[entry.Kind]; !ok {
+			return fmt.Errorf("unknown world event kind %q in event schedule", entry.Kind)
+		}
+		if entry.IntervalSeconds <= 0 || entry.DurationSeconds <= 0 {
+			return fmt.Errorf("event schedule entry %q needs a positive interval and duration", entry.Kind)
+		}
+		if entry.DurationSeconds > entry.IntervalSeconds || entry.DelaySeconds < 0 {
+			return fmt.Errorf("event schedule entry %q must end before its next run", entry.Kind)
+		}
+	}
+	return nil
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
+
+// activeWorldEvent is a running event and its timing
+type activeWorldEvent struct {
+	id        string
+	event     WorldEvent
+	startedAt time.Time
+	endsAt    time.Time
+}
+
+// EventScheduler starts and ends world events according to an EventSchedule.
+// It has no lock of its own; callers must hold gsm.mu.
+type EventScheduler struct {
+	schedule  *EventSchedule
+	kinds     map[string]func() WorldEvent
+	nextAt    []time.Time         // Next start per schedule entry
+	active    []*activeWorldEvent // Running event per schedule entry, nil when idle
+	idCounter int
+}
+
+func NewEventScheduler(schedule *EventSchedule, now time.Time) *EventScheduler {
+	return newEventScheduler(schedule, worldEventKinds, now)
+}
+
+// newEventScheduler runs a schedule whose kinds come from kinds instead of the world
+// event registry, for timers that reuse the scheduling but are never broadcast
+func newEventScheduler(schedule *EventSchedule, kinds map[string]func() WorldEvent, now time.Time) *EventScheduler {
+	es := &EventScheduler{schedule: schedule, kinds: kinds}
+	es.Reset(nil, now)
+	return es
+}
+
+func (es *EventScheduler) Update(gsm *GameStateManager, now time.Time) {
+	for i, entry := range es.schedule.Events {
+		if running := es.active[i]; running != nil {
+			// Ending on endsAt lets an event lasting its whole interval start again on time
+			if !now.Before(running.endsAt) {
+				es.end(gsm, i, now)
+			} else {
+				running.event.Update(gsm, now)
+			}
+		}
+
+		if now.Before(es.nextAt[i]) {
+			continue
+		}
+		es.nextAt[i] = now.Add(seconds(entry.IntervalSeconds))
+		if es.active[i] != nil {
+			continue
+		}
+
+		event := es.kinds[entry.Kind]()
+		if !event.Start(gsm, now) {
+			continue
+		}
+		es.idCounter++
+		es.active[i] = &activeWorldEvent{
+			id:        fmt.Sprintf("%s-%d", entry.Kind, es.idCounter),
+			event:     event,
+			startedAt: now,
+			endsAt:    now.Add(seconds(entry.DurationSeconds)),
+		}
+		logger.Debug("[WorldEvent] %s started, ends at %v", es.active[i].id, es.active[i].endsAt)
+	}
+}
+
+func (es *EventScheduler) end(gsm *GameStateManager, i int, now time.Time) {
+	running := es.active[i]
+	running.event.End(gsm, now)
+	es.active[i] = nil
+	logger.Debug("[WorldEvent] %s ended", running.id)
+}
+
+// Reset ends every running event and restarts the schedule from now. gsm may be nil
+// when nothing can be running yet.
+func (es *EventScheduler) Reset(gsm *GameStateManager, now time.Time) {
+	for i := range es.active {
+		if es.active[i] != nil {
+			es.end(gsm, i, now)
+		}
+	}
+
+	es.nextAt = make([]time.Time, len(es.schedule.Events))
+	es.active = make([]*activeWorldEvent, len(es.schedule.Events))
+	for i, entry := range es.schedule.Events {
+		delay := seconds(entry.DelaySeconds)
+		if delay == 0 {
+			delay = seconds(entry.IntervalSeconds)
+		}
+		es.nextAt[i] = now.Add(delay)
+	}
+}
+
+// nextStart is when schedule entry i next starts
+func (es *EventScheduler) nextStart(i int) time.Time {
+	return es.nextAt[i]
+}
+
+// find returns the first running event of a kind
+func (es *EventScheduler) find(kind multiplayerv1.WorldEventKind) *activeWorldEvent {
+	for _, running := range es.active {
+		if running != nil && running.event.Kind() == kind {
+			return running
+		}
+	}
+	return nil
+}
+
+// slowsAt reports whether a running event slows movement at a position
+func (es *EventScheduler) slowsAt(gameMap *GameMap, x, y float32) bool {
+	for _, running := range es.active {
+		if running == nil {
+			continue
+		}
+		if slowing, ok := running.event.(slowingEvent); ok && slowing.SlowsAt(gameMap, x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// visionScale is the fraction of the normal view radius players currently see
+func (es *EventScheduler) visionScale() float32 {
+	scale := float32(1)
+	for _, running := range es.active {
+		if running == nil {
+			continue
+		}
+		if vision, ok := running.event.(visionEvent); ok {
+			scale = min(scale, vision.VisionScale())
+		}
+	}
+	return scale
+}
+
+// buildWorldEvents returns the broadcast state of every running event in schedule order
+func (es *EventScheduler) buildWorldEvents(gsm *GameStateManager) []*multiplayerv1.WorldEvent {
+	var events []*multiplayerv1.WorldEvent
+	for _, running := range es.active {
+		if running == nil {
+			continue
+		}
+		state := &multiplayerv1.WorldEvent{
+			EventId:     running.id,
+			Kind:        running.event.Kind(),
+			StartedAtMs: unixMillis(running.startedAt),
+			EndsAtMs:    unixMillis(running.endsAt),
+		}
+		running.event.Describe(gsm, state)
+		events = append(events, state)
+	}
+	return events
+}
+
+// SetEventSchedule replaces the room's world event schedule, ending any running events
+func (gsm *GameStateManager) SetEventSchedule(schedule *EventSchedule) {
+	gsm.mu.Lock()
+	defer gsm.mu.Unlock()
+
+	now := gsm.now()
+	gsm.events.Reset(gsm, now)
+	gsm.events = NewEventScheduler(schedule, now)
+}
@@ -81,8 +81,10 @@ func WithWebSocket(path string, upgrader websocket.Upgrader) Option {
 			}
 
 			token := r.URL.Query().Get("token")
+			roomID := r.URL.Query().Get("room")
+			spectate := r.URL.Query().Get("spectate") == "true"
 
-			err = hub.NewClient(s.hub, conn, token)
+			err = hub.NewClient(s.hub, conn, token, roomID, spectate)
 			if err != nil {
 				logger.Warn("Failed to create client: %v", err)
 				return